@@ -0,0 +1,40 @@
+package main
+
+import (
+	"context"
+	"os"
+	"os/signal"
+	"syscall"
+
+	"github.com/sabry-awad97/task-manager/internal/config"
+	"github.com/sabry-awad97/task-manager/internal/daemon"
+	"github.com/sabry-awad97/task-manager/internal/storage"
+)
+
+// runDaemon sends reminders for the stored tasks until interrupted
+func runDaemon(cfg config.Config) error {
+	ctx, stop := signal.NotifyContext(context.Background(), os.Interrupt, syscall.SIGTERM)
+	defer stop()
+
+	store := storage.NewJSONStore("tasks.json")
+	d := daemon.New(store, daemon.SystemClock(), cfg.Reminders.Interval, notifiers(cfg.Reminders), os.Stderr)
+	return d.Run(ctx)
+}
+
+// notifiers builds the notifiers named in the config, in order
+func notifiers(cfg config.Reminders) []daemon.Notifier {
+	var list []daemon.Notifier
+	for _, name := range cfg.Notifiers {
+		switch name {
+		case config.NotifierStdout:
+			list = append(list, daemon.NewWriterNotifier(os.Stdout))
+		case config.NotifierBell:
+			list = append(list, daemon.NewBellNotifier(os.Stdout))
+		case config.NotifierCommand:
+			list = append(list, daemon.NewCommandNotifier(cfg.Command))
+		case config.NotifierExec:
+			list = append(list, daemon.NewExecNotifier(cfg.Exec))
+		}
+	}
+	return list
+}
@@ -1,16 +1,50 @@
 package main
 
 import (
+	"flag"
 	"fmt"
 	"os"
 
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sabry-awad97/task-manager/internal/config"
 	"github.com/sabry-awad97/task-manager/internal/tui"
 )
 
 func main() {
+	ascii := flag.Bool("ascii", false, "use text glyphs instead of emoji")
+	screenReader := flag.Bool("screen-reader", false, "plain linear layout for screen readers")
+	flag.Parse()
+
+	cfg, err := config.Load("config.toml")
+	if err != nil {
+		fmt.Printf("Error loading config: %v", err)
+		os.Exit(1)
+	}
+
+	// Flags and the environment win over the config file
+	cfg.ASCII = cfg.ASCII || *ascii
+	cfg.ScreenReader = cfg.ScreenReader || *screenReader
+	if os.Getenv("NO_COLOR") != "" {
+		cfg.NoColor = true
+	}
+
+	// "task-manager daemon" sends reminders instead of opening the TUI
+	if flag.Arg(0) == "daemon" {
+		if err := runDaemon(cfg); err != nil {
+			fmt.Printf("Error running daemon: %v", err)
+			os.Exit(1)
+		}
+		return
+	}
+
+	model, err := tui.NewRootModel(cfg)
+	if err != nil {
+		fmt.Printf("Error loading config: %v", err)
+		os.Exit(1)
+	}
+
 	p := tea.NewProgram(
-		tui.NewRootModel(),
+		model,
 		tea.WithAltScreen(),
 		tea.WithMouseCellMotion(), // Enable mouse motion
 		tea.WithMouseAllMotion(),  // Enable all mouse events
@@ -0,0 +1,377 @@
+package config
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/BurntSushi/toml"
+)
+
+// Landing views accepted by LandingView
+const (
+	LandingTable  = "table"
+	LandingAgenda = "agenda"
+)
+
+// Task table columns accepted by Columns
+const (
+	ColumnTitle     = "title"
+	ColumnDueDate   = "due_date"
+	ColumnDue       = "due"
+	ColumnPriority  = "priority"
+	ColumnStatus    = "status"
+	ColumnActions   = "actions"
+	ColumnEstimate  = "estimate"
+	ColumnEffort    = "effort"    // Tracked time against the estimate
+	ColumnChecklist = "checklist" // Checked items out of all, e.g. "2/4"
+)
+
+// DefaultStatuses is the workflow used when Statuses is not set
+var DefaultStatuses = []Status{
+	{ID: "backlog", Name: "Backlog", Color: "#7D7D7D", Icon: "📥", Next: []string{"todo", "cancelled"}},
+	{ID: "todo", Name: "Todo", Color: "#5DADE2", Icon: "⏳", Next: []string{"in_progress", "backlog", "cancelled"}},
+	{ID: "in_progress", Name: "In Progress", Color: "#F5B041", Icon: "🔨", Next: []string{"review", "todo", "cancelled"}},
+	{ID: "review", Name: "Review", Color: "#AF7AC5", Icon: "👀", Next: []string{"done", "in_progress", "cancelled"}},
+	{ID: "done", Name: "Done", Color: "#44B556", Icon: "✅", Done: true, Next: []string{"todo"}},
+	{ID: "cancelled", Name: "Cancelled", Color: "#E74C3C", Icon: "🚫", Done: true, Next: []string{"todo"}},
+}
+
+// DefaultPriorities is the priority scale used when Priorities is not set
+var DefaultPriorities = []Priority{{Name: "Low"}, {Name: "Medium"}, {Name: "High"}}
+
+// DefaultColumns is the task table layout used when Columns is not set
+var DefaultColumns = []string{ColumnTitle, ColumnDueDate, ColumnDue, ColumnPriority, ColumnEstimate, ColumnChecklist, ColumnStatus, ColumnActions}
+
+type Config struct {
+	// LandingView is the screen shown on startup: "table" or "agenda"
+	LandingView string    `toml:"landing_view"`
+	Highlight   Highlight `toml:"highlight"`
+
+	// Columns lists the task table columns in display order. Title is
+	// required; narrow terminals hide the others as needed.
+	Columns []string `toml:"columns"`
+
+	// Keymap is the base key preset: "default", "vim" or "emacs"
+	Keymap string `toml:"keymap"`
+	// Keys overrides single bindings by id, e.g. "main.new" = ["n", "+"].
+	// An empty list unbinds the action.
+	Keys map[string][]string `toml:"keys"`
+
+	// Theme names a built-in theme, a custom theme from Themes, or "auto"
+	// to follow the terminal background
+	Theme  string           `toml:"theme"`
+	Themes map[string]Theme `toml:"themes"`
+
+	// ASCII draws text glyphs instead of emoji and box drawing characters
+	ASCII bool `toml:"ascii"`
+	// NoColor turns colours off and marks state with text. NO_COLOR sets it too.
+	NoColor bool `toml:"no_color"`
+	// ScreenReader lays views out as plain lines instead of tables and frames
+	ScreenReader bool `toml:"screen_reader"`
+
+	// TrashDays is how long deleted tasks stay in the trash before they are
+	// purged at startup. 0 keeps them until purged by hand.
+	TrashDays int `toml:"trash_days"`
+
+	// Statuses are the workflow states in board order. InitialStatus is
+	// the state new tasks start in.
+	Statuses      []Status `toml:"statuses"`
+	InitialStatus string   `toml:"initial_status"`
+
+	// Priorities is the priority scale, lowest first. ImportantPriority
+	// names the lowest level the Eisenhower matrix counts as important;
+	// empty means only the highest. Tasks due within UrgentWithin, or
+	// overdue, count as urgent.
+	Priorities        []Priority    `toml:"priorities"`
+	ImportantPriority string        `toml:"important_priority"`
+	UrgentWithin      time.Duration `toml:"urgent_within"`
+
+	// ArchiveDays moves tasks completed more than this many days ago to the
+	// archive at startup. 0 only archives by hand.
+	ArchiveDays int `toml:"archive_days"`
+
+	// WorkdayHours is how long a "1d" estimate lasts and how much work the
+	// agenda plans for a day
+	WorkdayHours float64 `toml:"workday_hours"`
+
+	Pomodoro  Pomodoro  `toml:"pomodoro"`
+	Reminders Reminders `toml:"reminders"`
+}
+
+// Status is a workflow state. Next lists the states a task may move to;
+// space advances it to the first one.
+type Status struct {
+	ID    string   `toml:"id"`
+	Name  string   `toml:"name"`
+	Color string   `toml:"color"`
+	Icon  string   `toml:"icon"`
+	Done  bool     `toml:"done"` // Tasks in this state count as completed
+	Next  []string `toml:"next"`
+}
+
+// Priority is one level of the priority scale. An empty colour picks the
+// theme's low, medium or high colour by position on the scale.
+type Priority struct {
+	Name  string `toml:"name"`
+	Color string `toml:"color"`
+}
+
+// Theme is a custom colour palette. Unset colours come from the Base theme.
+// Colours are ANSI numbers or hex values.
+type Theme struct {
+	Base      string   `toml:"base"`
+	Accent    string   `toml:"accent"`
+	Secondary string   `toml:"secondary"`
+	Border    string   `toml:"border"`
+	Muted     string   `toml:"muted"`
+	Text      string   `toml:"text"`
+	OnAccent  string   `toml:"on_accent"`
+	Marked    string   `toml:"marked"`
+	Success   string   `toml:"success"`
+	Warning   string   `toml:"warning"`
+	Danger    string   `toml:"danger"`
+	Priority  []string `toml:"priority"` // Low, medium and high
+}
+
+// Pomodoro sets the lengths of the focus view's work and break phases.
+// Every LongBreakEvery pomodoros the break lasts LongBreak; 0 turns long
+// breaks off. Bell rings the terminal bell when a phase ends.
+type Pomodoro struct {
+	Work           time.Duration `toml:"work"`
+	Break          time.Duration `toml:"break"`
+	LongBreak      time.Duration `toml:"long_break"`
+	LongBreakEvery int           `toml:"long_break_every"`
+	Bell           bool          `toml:"bell"`
+}
+
+// Notifiers the reminder daemon can send reminders through
+const (
+	NotifierStdout  = "stdout"  // A line on standard output
+	NotifierBell    = "bell"    // The terminal bell
+	NotifierCommand = "command" // Command, e.g. notify-send, with {title} and {message} filled in
+	NotifierExec    = "exec"    // Exec, given the task as JSON on stdin and in TASK_* variables
+)
+
+// Reminders configures the reminder daemon. It checks the task store every
+// Interval and sends due reminders through each of Notifiers.
+type Reminders struct {
+	Interval  time.Duration `toml:"interval"`
+	Notifiers []string      `toml:"notifiers"`
+	Command   []string      `toml:"command"`
+	Exec      []string      `toml:"exec"`
+}
+
+// Highlight controls how the task table marks overdue, due-soon and completed rows.
+// Empty colours use the theme's danger, warning and muted colours.
+type Highlight struct {
+	DueSoon         time.Duration `toml:"due_soon"`
+	OverdueColor    string        `toml:"overdue_color"`
+	DueSoonColor    string        `toml:"due_soon_color"`
+	CompletedColor  string        `toml:"completed_color"`
+	StrikeCompleted bool          `toml:"strike_completed"`
+}
+
+func Default() Config {
+	return Config{
+		LandingView:   LandingTable,
+		Keymap:        "default",
+		Theme:         "auto",
+		Columns:       DefaultColumns,
+		TrashDays:     30,
+		Statuses:      DefaultStatuses,
+		InitialStatus: "todo",
+		Priorities:    DefaultPriorities,
+		UrgentWithin:  48 * time.Hour,
+		WorkdayHours:  8,
+		Pomodoro: Pomodoro{
+			Work:           25 * time.Minute,
+			Break:          5 * time.Minute,
+			LongBreak:      15 * time.Minute,
+			LongBreakEvery: 4,
+			Bell:           true,
+		},
+		Reminders: Reminders{
+			Interval:  30 * time.Second,
+			Notifiers: []string{NotifierStdout},
+			Command:   []string{"notify-send", "{title}", "{message}"},
+		},
+		Highlight: Highlight{
+			DueSoon:         24 * time.Hour,
+			StrikeCompleted: true,
+		},
+	}
+}
+
+// Load reads the TOML config at path on top of the defaults.
+// A missing file is not an error.
+func Load(path string) (Config, error) {
+	cfg := Default()
+
+	data, err := os.ReadFile(path)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return cfg, nil
+		}
+		return cfg, err
+	}
+
+	// Arrays of tables decode into the existing entries, so the workflow
+	// starts empty and falls back to the default one
+	cfg.Statuses = nil
+	cfg.Priorities = nil
+	if err := toml.Unmarshal(data, &cfg); err != nil {
+		return cfg, fmt.Errorf("parse %s: %w", path, err)
+	}
+	if len(cfg.Statuses) == 0 {
+		cfg.Statuses = DefaultStatuses
+	}
+	if len(cfg.Priorities) == 0 {
+		cfg.Priorities = DefaultPriorities
+	}
+
+	if err := cfg.validate(); err != nil {
+		return cfg, fmt.Errorf("%s: %w", path, err)
+	}
+
+	return cfg, nil
+}
+
+func (c Config) validate() error {
+	switch c.LandingView {
+	case LandingTable, LandingAgenda:
+	default:
+		return fmt.Errorf("unknown landing_view %q", c.LandingView)
+	}
+	seen := make(map[string]bool, len(c.Columns))
+	for _, col := range c.Columns {
+		switch col {
+		case ColumnTitle, ColumnDueDate, ColumnDue, ColumnPriority, ColumnStatus, ColumnActions, ColumnEstimate, ColumnEffort, ColumnChecklist:
+		default:
+			return fmt.Errorf("unknown column %q", col)
+		}
+		if seen[col] {
+			return fmt.Errorf("column %q listed twice", col)
+		}
+		seen[col] = true
+	}
+	if !seen[ColumnTitle] {
+		return fmt.Errorf("columns must include %q", ColumnTitle)
+	}
+	if err := c.validateStatuses(); err != nil {
+		return err
+	}
+	if err := c.validatePriorities(); err != nil {
+		return err
+	}
+	if c.UrgentWithin < 0 {
+		return fmt.Errorf("urgent_within must not be negative")
+	}
+	if c.TrashDays < 0 {
+		return fmt.Errorf("trash_days must not be negative")
+	}
+	if c.ArchiveDays < 0 {
+		return fmt.Errorf("archive_days must not be negative")
+	}
+	if c.WorkdayHours <= 0 || c.WorkdayHours > 24 {
+		return fmt.Errorf("workday_hours must be between 0 and 24")
+	}
+	if c.Pomodoro.Work < time.Minute || c.Pomodoro.Break < time.Minute || c.Pomodoro.LongBreak < time.Minute {
+		return fmt.Errorf("pomodoro work and break lengths must be at least a minute")
+	}
+	if c.Pomodoro.LongBreakEvery < 0 {
+		return fmt.Errorf("pomodoro.long_break_every must not be negative")
+	}
+	if err := c.Reminders.validate(); err != nil {
+		return err
+	}
+	if c.Highlight.DueSoon < 0 {
+		return fmt.Errorf("highlight.due_soon must not be negative")
+	}
+	return nil
+}
+
+// validateStatuses checks that the workflow is complete and that every
+// transition names a known state
+func (c Config) validateStatuses() error {
+	if len(c.Statuses) == 0 {
+		return fmt.Errorf("statuses must not be empty")
+	}
+	known := make(map[string]Status, len(c.Statuses))
+	done := false
+	for _, s := range c.Statuses {
+		if s.ID == "" {
+			return fmt.Errorf("status %q has no id", s.Name)
+		}
+		if _, ok := known[s.ID]; ok {
+			return fmt.Errorf("status %q listed twice", s.ID)
+		}
+		known[s.ID] = s
+		done = done || s.Done
+	}
+	if !done {
+		return fmt.Errorf("statuses need at least one done state")
+	}
+	for _, s := range c.Statuses {
+		for _, next := range s.Next {
+			if _, ok := known[next]; !ok {
+				return fmt.Errorf("status %q moves to unknown status %q", s.ID, next)
+			}
+		}
+	}
+	initial, ok := known[c.InitialStatus]
+	if !ok {
+		return fmt.Errorf("unknown initial_status %q", c.InitialStatus)
+	}
+	if initial.Done {
+		return fmt.Errorf("initial_status %q must not be a done state", c.InitialStatus)
+	}
+	return nil
+}
+
+// validatePriorities checks that priority names are set and unique, since
+// bulk edits pick a level by name
+func (c Config) validatePriorities() error {
+	if len(c.Priorities) == 0 {
+		return fmt.Errorf("priorities must not be empty")
+	}
+	seen := make(map[string]bool, len(c.Priorities))
+	for _, p := range c.Priorities {
+		name := strings.ToLower(p.Name)
+		if name == "" {
+			return fmt.Errorf("priority has no name")
+		}
+		if seen[name] {
+			return fmt.Errorf("priority %q listed twice", p.Name)
+		}
+		seen[name] = true
+	}
+	if c.ImportantPriority != "" && !seen[strings.ToLower(c.ImportantPriority)] {
+		return fmt.Errorf("unknown important_priority %q", c.ImportantPriority)
+	}
+	return nil
+}
+
+// validate checks the interval and that each notifier is known and set up
+func (r Reminders) validate() error {
+	if r.Interval < time.Second {
+		return fmt.Errorf("reminders.interval must be at least a second")
+	}
+	for _, n := range r.Notifiers {
+		switch n {
+		case NotifierStdout, NotifierBell:
+		case NotifierCommand:
+			if len(r.Command) == 0 {
+				return fmt.Errorf("reminders.command must be set to use the %q notifier", n)
+			}
+		case NotifierExec:
+			if len(r.Exec) == 0 {
+				return fmt.Errorf("reminders.exec must be set to use the %q notifier", n)
+			}
+		default:
+			return fmt.Errorf("unknown reminder notifier %q", n)
+		}
+	}
+	return nil
+}
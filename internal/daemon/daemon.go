@@ -0,0 +1,122 @@
+// Package daemon sends task reminders while the TUI is closed
+package daemon
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"time"
+
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+// Store is where the daemon reads tasks from. ModTime tells it when to
+// read them again.
+type Store interface {
+	Load() ([]models.Task, error)
+	ModTime() (time.Time, error)
+}
+
+// Clock tells the time and waits. Tests swap in a clock they move by hand.
+type Clock interface {
+	Now() time.Time
+	After(d time.Duration) <-chan time.Time
+}
+
+type systemClock struct{}
+
+func (systemClock) Now() time.Time                         { return time.Now() }
+func (systemClock) After(d time.Duration) <-chan time.Time { return time.After(d) }
+
+// SystemClock is the wall clock
+func SystemClock() Clock {
+	return systemClock{}
+}
+
+// Daemon checks the store on an interval and sends the reminders that went
+// off since the last check
+type Daemon struct {
+	store     Store
+	clock     Clock
+	interval  time.Duration
+	notifiers []Notifier
+	errs      io.Writer // Failed loads and notifications are reported here
+
+	tasks   []models.Task
+	loaded  bool      // The tasks have been read at least once
+	modTime time.Time // When the loaded tasks were saved
+	last    time.Time // Reminders up to this time have been sent
+}
+
+func New(store Store, clock Clock, interval time.Duration, notifiers []Notifier, errs io.Writer) *Daemon {
+	return &Daemon{
+		store:     store,
+		clock:     clock,
+		interval:  interval,
+		notifiers: notifiers,
+		errs:      errs,
+	}
+}
+
+// Run checks for reminders until ctx is done. Reminders that went off
+// before it started are not sent.
+func (d *Daemon) Run(ctx context.Context) error {
+	d.last = d.clock.Now()
+	if err := d.reload(); err != nil {
+		return err
+	}
+	for {
+		select {
+		case <-ctx.Done():
+			return nil
+		case <-d.clock.After(d.interval):
+			if err := d.Check(ctx); err != nil {
+				fmt.Fprintln(d.errs, err)
+			}
+		}
+	}
+}
+
+// Check sends the reminders that went off since the last check, reading
+// the tasks again first if the store changed
+func (d *Daemon) Check(ctx context.Context) error {
+	now := d.clock.Now()
+	if err := d.reload(); err != nil {
+		return err
+	}
+
+	var errs []error
+	for _, task := range d.tasks {
+		for _, r := range task.RemindersBetween(d.last, now) {
+			n := Notification{Task: task, Reminder: r, At: r.Time(task.DueDate)}
+			for _, notifier := range d.notifiers {
+				if err := notifier.Notify(ctx, n); err != nil {
+					errs = append(errs, fmt.Errorf("remind of %q: %w", task.Title, err))
+				}
+			}
+		}
+	}
+	// A failed notifier is not retried; the reminder would repeat on every check
+	d.last = now
+	return errors.Join(errs...)
+}
+
+// reload reads the tasks when the store was written since they were loaded
+func (d *Daemon) reload() error {
+	modTime, err := d.store.ModTime()
+	if err != nil {
+		return fmt.Errorf("check tasks: %w", err)
+	}
+	if d.loaded && modTime.Equal(d.modTime) {
+		return nil
+	}
+	tasks, err := d.store.Load()
+	if err != nil {
+		return fmt.Errorf("load tasks: %w", err)
+	}
+	d.tasks = tasks
+	d.loaded = true
+	d.modTime = modTime
+	return nil
+}
@@ -0,0 +1,157 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+// fakeClock is a clock the tests move by hand
+type fakeClock struct {
+	now time.Time
+}
+
+func (c *fakeClock) Now() time.Time                       { return c.now }
+func (c *fakeClock) After(time.Duration) <-chan time.Time { return make(chan time.Time) }
+
+// fakeStore serves tasks from memory and counts the loads
+type fakeStore struct {
+	tasks   []models.Task
+	modTime time.Time
+	loads   int
+}
+
+func (s *fakeStore) Load() ([]models.Task, error) {
+	s.loads++
+	return s.tasks, nil
+}
+
+func (s *fakeStore) ModTime() (time.Time, error) {
+	return s.modTime, nil
+}
+
+// recorder keeps the notifications it is sent
+type recorder struct {
+	sent []Notification
+	err  error
+}
+
+func (r *recorder) Notify(_ context.Context, n Notification) error {
+	r.sent = append(r.sent, n)
+	return r.err
+}
+
+func dueTask(title string, due time.Time, reminders ...models.Reminder) models.Task {
+	task := models.NewTask(title, "", due, models.Low)
+	task.Reminders = reminders
+	return task
+}
+
+func TestCheckSendsRemindersOnce(t *testing.T) {
+	due := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	// A task due on a day is due until the end of it
+	deadline := time.Date(2026, 10, 21, 0, 0, 0, 0, time.Local)
+	morning := time.Date(2026, 10, 20, 9, 0, 0, 0, time.Local)
+	store := &fakeStore{tasks: []models.Task{
+		dueTask("call", due, models.Reminder{At: "09:00"}, models.Reminder{Before: 15 * time.Minute}),
+	}}
+	clock := &fakeClock{now: morning.Add(-time.Hour)}
+	rec := &recorder{}
+	d := New(store, clock, time.Minute, []Notifier{rec}, &bytes.Buffer{})
+	d.last = clock.Now()
+
+	clock.now = morning.Add(30 * time.Minute)
+	if err := d.Check(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.sent) != 1 || rec.sent[0].Reminder.At != "09:00" {
+		t.Fatalf("first check sent %v", rec.sent)
+	}
+
+	// Nothing new until a quarter before the end of the day
+	clock.now = deadline.Add(-time.Hour)
+	if err := d.Check(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.sent) != 1 {
+		t.Fatalf("second check sent %d reminders", len(rec.sent))
+	}
+
+	clock.now = deadline.Add(-10 * time.Minute)
+	if err := d.Check(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.sent) != 2 || !rec.sent[1].At.Equal(deadline.Add(-15*time.Minute)) {
+		t.Fatalf("third check sent %v", rec.sent)
+	}
+}
+
+func TestCheckSkipsCompletedTasks(t *testing.T) {
+	due := time.Now().UTC().AddDate(0, 0, 1)
+	task := dueTask("done", due, models.Reminder{Before: 48 * time.Hour})
+	task.Completed = true
+	store := &fakeStore{tasks: []models.Task{task}}
+	clock := &fakeClock{now: time.Now().AddDate(0, 0, -3)}
+	rec := &recorder{}
+	d := New(store, clock, time.Minute, []Notifier{rec}, &bytes.Buffer{})
+	d.last = clock.Now()
+
+	clock.now = time.Now()
+	if err := d.Check(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if len(rec.sent) != 0 {
+		t.Fatalf("sent %v", rec.sent)
+	}
+}
+
+func TestCheckReloadsChangedStore(t *testing.T) {
+	due := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	deadline := time.Date(2026, 10, 21, 0, 0, 0, 0, time.Local)
+	store := &fakeStore{}
+	clock := &fakeClock{now: deadline.Add(-time.Hour)}
+	rec := &recorder{}
+	d := New(store, clock, time.Minute, []Notifier{rec}, &bytes.Buffer{})
+	d.last = clock.Now()
+
+	if err := d.Check(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if err := d.Check(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if store.loads != 1 {
+		t.Fatalf("loaded %d times from an unchanged store", store.loads)
+	}
+
+	store.tasks = []models.Task{dueTask("new", due, models.Reminder{Before: 5 * time.Minute})}
+	store.modTime = store.modTime.Add(time.Second)
+	clock.now = deadline
+	if err := d.Check(context.Background()); err != nil {
+		t.Fatal(err)
+	}
+	if store.loads != 2 || len(rec.sent) != 1 {
+		t.Fatalf("loads %d, sent %d", store.loads, len(rec.sent))
+	}
+}
+
+func TestCheckReportsNotifierErrors(t *testing.T) {
+	due := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	deadline := time.Date(2026, 10, 21, 0, 0, 0, 0, time.Local)
+	store := &fakeStore{tasks: []models.Task{dueTask("call", due, models.Reminder{})}}
+	clock := &fakeClock{now: deadline.Add(-time.Minute)}
+	rec := &recorder{err: errors.New("no display")}
+	d := New(store, clock, time.Minute, []Notifier{rec, NewCommandNotifier(nil)}, &bytes.Buffer{})
+	d.last = clock.Now()
+
+	clock.now = deadline
+	err := d.Check(context.Background())
+	if err == nil || !strings.Contains(err.Error(), "no display") || !strings.Contains(err.Error(), "no notification command") {
+		t.Fatalf("err = %v", err)
+	}
+}
@@ -0,0 +1,139 @@
+package daemon
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"os"
+	"os/exec"
+	"strings"
+	"time"
+
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+// Notification is a reminder that went off for a task
+type Notification struct {
+	Task     models.Task
+	Reminder models.Reminder
+	At       time.Time // When the reminder was set to go off
+}
+
+// Title names the task the reminder is about
+func (n Notification) Title() string {
+	return n.Task.Title
+}
+
+// Message says when the task is due, e.g. "Due Mon Oct 19 09:00 (15m before)"
+func (n Notification) Message() string {
+	// Due dates are saved as midnight UTC; their clock is the local one
+	due := n.Task.DueDate
+	when := due.Format("Mon Jan 2 15:04")
+	// Tasks due on a day rather than at a time
+	if due.Hour() == 0 && due.Minute() == 0 {
+		when = due.Format("Mon Jan 2")
+	}
+	return fmt.Sprintf("Due %s (%s)", when, n.Reminder)
+}
+
+// Notifier delivers reminders
+type Notifier interface {
+	Notify(ctx context.Context, n Notification) error
+}
+
+// WriterNotifier writes a line per reminder, e.g. to standard output
+type WriterNotifier struct {
+	w io.Writer
+}
+
+func NewWriterNotifier(w io.Writer) WriterNotifier {
+	return WriterNotifier{w: w}
+}
+
+func (s WriterNotifier) Notify(_ context.Context, n Notification) error {
+	_, err := fmt.Fprintf(s.w, "%s  %s: %s\n", n.At.Local().Format("2006-01-02 15:04"), n.Title(), n.Message())
+	return err
+}
+
+// BellNotifier rings the terminal bell
+type BellNotifier struct {
+	w io.Writer
+}
+
+func NewBellNotifier(w io.Writer) BellNotifier {
+	return BellNotifier{w: w}
+}
+
+func (b BellNotifier) Notify(context.Context, Notification) error {
+	_, err := io.WriteString(b.w, "\a")
+	return err
+}
+
+// CommandNotifier runs a desktop notification command such as notify-send.
+// "{title}" and "{message}" in its arguments are replaced by the reminder's.
+type CommandNotifier struct {
+	args []string
+}
+
+func NewCommandNotifier(args []string) CommandNotifier {
+	return CommandNotifier{args: args}
+}
+
+func (c CommandNotifier) Notify(ctx context.Context, n Notification) error {
+	if len(c.args) == 0 {
+		return errors.New("no notification command")
+	}
+	fill := strings.NewReplacer("{title}", n.Title(), "{message}", n.Message())
+	args := make([]string, len(c.args))
+	for i, arg := range c.args {
+		args[i] = fill.Replace(arg)
+	}
+	return run(exec.CommandContext(ctx, args[0], args[1:]...))
+}
+
+// ExecNotifier runs a hook with the task as JSON on its standard input and
+// the reminder in TASK_ID, TASK_TITLE, TASK_DUE, TASK_REMINDER and
+// TASK_MESSAGE
+type ExecNotifier struct {
+	args []string
+}
+
+func NewExecNotifier(args []string) ExecNotifier {
+	return ExecNotifier{args: args}
+}
+
+func (e ExecNotifier) Notify(ctx context.Context, n Notification) error {
+	if len(e.args) == 0 {
+		return errors.New("no hook command")
+	}
+	data, err := json.Marshal(n.Task)
+	if err != nil {
+		return err
+	}
+	cmd := exec.CommandContext(ctx, e.args[0], e.args[1:]...)
+	cmd.Stdin = bytes.NewReader(data)
+	cmd.Env = append(os.Environ(),
+		"TASK_ID="+n.Task.ID,
+		"TASK_TITLE="+n.Task.Title,
+		"TASK_DUE="+n.Task.DueDate.Format(time.RFC3339),
+		"TASK_REMINDER="+n.At.Format(time.RFC3339),
+		"TASK_MESSAGE="+n.Message(),
+	)
+	return run(cmd)
+}
+
+// run runs cmd and puts what it printed to standard error into the error
+func run(cmd *exec.Cmd) error {
+	var stderr bytes.Buffer
+	cmd.Stderr = &stderr
+	if err := cmd.Run(); err != nil {
+		if msg := strings.TrimSpace(stderr.String()); msg != "" {
+			return fmt.Errorf("%s: %w: %s", cmd.Args[0], err, msg)
+		}
+		return fmt.Errorf("%s: %w", cmd.Args[0], err)
+	}
+	return nil
+}
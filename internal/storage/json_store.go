@@ -3,8 +3,10 @@ package storage
 import (
 	"encoding/json"
 	"os"
+	"slices"
 	"strings"
 	"sync"
+	"time"
 
 	"github.com/sabry-awad97/task-manager/internal/tui/models"
 )
@@ -22,18 +24,76 @@ func (s *JSONStore) Save(tasks []models.Task) error {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
-	data, err := json.MarshalIndent(tasks, "", strings.Repeat(" ", 2))
+	return s.save(tasks)
+}
+
+func (s *JSONStore) Load() ([]models.Task, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	return s.load()
+}
+
+// Append adds tasks to the end of the stored list
+func (s *JSONStore) Append(tasks []models.Task) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	stored, err := s.load()
 	if err != nil {
 		return err
 	}
-
-	return os.WriteFile(s.filePath, data, 0644)
+	return s.save(append(stored, tasks...))
 }
 
-func (s *JSONStore) Load() ([]models.Task, error) {
+// Remove takes the tasks with the given IDs out of the stored list and
+// returns them
+func (s *JSONStore) Remove(ids []string) ([]models.Task, error) {
 	s.mu.Lock()
 	defer s.mu.Unlock()
 
+	stored, err := s.load()
+	if err != nil {
+		return nil, err
+	}
+
+	var removed []models.Task
+	kept := slices.DeleteFunc(stored, func(task models.Task) bool {
+		if slices.Contains(ids, task.ID) {
+			removed = append(removed, task)
+			return true
+		}
+		return false
+	})
+	if len(removed) == 0 {
+		return nil, nil
+	}
+	return removed, s.save(kept)
+}
+
+// ModTime is when the stored list was last written, or the zero time when
+// nothing has been saved yet
+func (s *JSONStore) ModTime() (time.Time, error) {
+	info, err := os.Stat(s.filePath)
+	if err != nil {
+		if os.IsNotExist(err) {
+			return time.Time{}, nil
+		}
+		return time.Time{}, err
+	}
+	return info.ModTime(), nil
+}
+
+func (s *JSONStore) save(tasks []models.Task) error {
+	data, err := json.MarshalIndent(tasks, "", strings.Repeat(" ", 2))
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(s.filePath, data, 0644)
+}
+
+func (s *JSONStore) load() ([]models.Task, error) {
 	data, err := os.ReadFile(s.filePath)
 	if err != nil {
 		if os.IsNotExist(err) {
@@ -0,0 +1,58 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+)
+
+// ChecklistItem is one step of a task's checklist
+type ChecklistItem struct {
+	Text    string `json:"text"`
+	Checked bool   `json:"checked,omitempty"`
+}
+
+// checklistLine matches a Markdown task list item such as "- [ ] call back"
+// or "* [x] book room"
+var checklistLine = regexp.MustCompile(`^\s*[-*+]\s+\[([ xX])\]\s+(.*\S)\s*$`)
+
+// ImportChecklist moves the Markdown task list items in the description to
+// the end of the checklist. It reports whether there were any.
+func (t *Task) ImportChecklist() bool {
+	var kept []string
+	var items []ChecklistItem
+	for _, line := range strings.Split(t.Description, "\n") {
+		match := checklistLine.FindStringSubmatch(line)
+		if match == nil {
+			kept = append(kept, line)
+			continue
+		}
+		items = append(items, ChecklistItem{Text: match[2], Checked: match[1] != " "})
+	}
+	if len(items) == 0 {
+		return false
+	}
+	t.Description = strings.TrimSpace(strings.Join(kept, "\n"))
+	t.Checklist = append(slices.Clip(t.Checklist), items...)
+	return true
+}
+
+// ChecklistProgress counts the checked items and all items
+func (t Task) ChecklistProgress() (checked, total int) {
+	for _, item := range t.Checklist {
+		if item.Checked {
+			checked++
+		}
+	}
+	return checked, len(t.Checklist)
+}
+
+// formatProgress writes checklist progress as "2/4", or nothing without items
+func formatProgress(items []ChecklistItem) string {
+	checked, total := Task{Checklist: items}.ChecklistProgress()
+	if total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", checked, total)
+}
@@ -0,0 +1,81 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// workday is how long a "1d" estimate lasts
+var workday = 8 * time.Hour
+
+// SetWorkday sets the length of a working day for estimates. Call it before
+// building the views.
+func SetWorkday(d time.Duration) {
+	if d > 0 {
+		workday = d
+	}
+}
+
+// Workday returns the length of a working day
+func Workday() time.Duration {
+	return workday
+}
+
+// estimatePart matches one "<number><unit>" piece of an estimate, e.g. "1.5h"
+var estimatePart = regexp.MustCompile(`(\d+(?:\.\d+)?)\s*([wdhm])`)
+
+// ParseEstimate reads an effort estimate such as "30m", "2h", "1d" or
+// "1d 4h". Days are working days and weeks are five of them. An empty
+// string means no estimate.
+func ParseEstimate(s string) (time.Duration, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if s == "" {
+		return 0, nil
+	}
+
+	units := map[string]time.Duration{
+		"w": 5 * workday,
+		"d": workday,
+		"h": time.Hour,
+		"m": time.Minute,
+	}
+	var total time.Duration
+	rest := s
+	for _, part := range estimatePart.FindAllStringSubmatch(s, -1) {
+		n, err := strconv.ParseFloat(part[1], 64)
+		if err != nil {
+			return 0, fmt.Errorf("invalid estimate %q", s)
+		}
+		total += time.Duration(n * float64(units[part[2]]))
+		rest = strings.Replace(rest, part[0], "", 1)
+	}
+	if strings.TrimSpace(rest) != "" || total <= 0 {
+		return 0, fmt.Errorf("invalid estimate %q (e.g. 30m, 2h, 1d)", s)
+	}
+	return total.Round(time.Minute), nil
+}
+
+// FormatEstimate writes an estimate back in working days, hours and
+// minutes, e.g. "1d 2h"
+func FormatEstimate(d time.Duration) string {
+	d = d.Round(time.Minute)
+	if d <= 0 {
+		return "0m"
+	}
+	var parts []string
+	if days := d / workday; days > 0 {
+		parts = append(parts, fmt.Sprintf("%dd", days))
+		d -= days * workday
+	}
+	if hours := d / time.Hour; hours > 0 {
+		parts = append(parts, fmt.Sprintf("%dh", hours))
+		d -= hours * time.Hour
+	}
+	if minutes := d / time.Minute; minutes > 0 {
+		parts = append(parts, fmt.Sprintf("%dm", minutes))
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,116 @@
+package models
+
+import (
+	"slices"
+	"strings"
+	"time"
+)
+
+// Change is one field edit in a task's activity log
+type Change struct {
+	At    time.Time `json:"at"`
+	By    string    `json:"by,omitempty"` // User who made the change, when known
+	Field string    `json:"field"`
+	From  string    `json:"from,omitempty"`
+	To    string    `json:"to,omitempty"`
+}
+
+// Fields named in the activity log
+const (
+	FieldTitle       = "title"
+	FieldDescription = "description"
+	FieldDueDate     = "due_date"
+	FieldPriority    = "priority"
+	FieldEstimate    = "estimate"
+	FieldReminders   = "reminders"
+	FieldDeferUntil  = "defer_until"
+	FieldChecklist   = "checklist"
+	FieldStatus      = "status"
+	FieldTags        = "tags"
+	FieldDeletedAt   = "deleted_at"
+	FieldArchived    = "archived"
+)
+
+// historyDateFormat keeps the time of day, unlike the due date shown in lists
+const historyDateFormat = "2006-01-02 15:04"
+
+// Diff lists the fields that differ between two versions of a task.
+// Priorities are logged by name and statuses by id.
+func Diff(before, after Task) []Change {
+	var changes []Change
+	add := func(field, from, to string) {
+		if from != to {
+			changes = append(changes, Change{Field: field, From: from, To: to})
+		}
+	}
+	add(FieldTitle, before.Title, after.Title)
+	add(FieldDescription, before.Description, after.Description)
+	if !before.DueDate.Equal(after.DueDate) {
+		add(FieldDueDate, before.DueDate.Format(historyDateFormat), after.DueDate.Format(historyDateFormat))
+	}
+	add(FieldPriority, before.Priority.String(), after.Priority.String())
+	if before.Estimate != after.Estimate {
+		add(FieldEstimate, formatEstimate(before.Estimate), formatEstimate(after.Estimate))
+	}
+	add(FieldDeferUntil, formatTime(before.DeferUntil), formatTime(after.DeferUntil))
+	if !slices.Equal(before.Reminders, after.Reminders) {
+		add(FieldReminders, FormatReminders(before.Reminders), FormatReminders(after.Reminders))
+	}
+	if !slices.Equal(before.Checklist, after.Checklist) {
+		// Progress only; reordering and rewording are not logged
+		add(FieldChecklist, formatProgress(before.Checklist), formatProgress(after.Checklist))
+	}
+	add(FieldStatus, before.Status, after.Status)
+	if !slices.Equal(before.Tags, after.Tags) {
+		add(FieldTags, strings.Join(before.Tags, ", "), strings.Join(after.Tags, ", "))
+	}
+	add(FieldDeletedAt, formatTime(before.DeletedAt), formatTime(after.DeletedAt))
+	return changes
+}
+
+// Record logs how the task differs from before and stamps UpdatedAt. It
+// reports whether anything changed.
+func (t *Task) Record(before Task, by string, now time.Time) bool {
+	changes := Diff(before, *t)
+	if len(changes) == 0 {
+		return false
+	}
+	for i := range changes {
+		changes[i].At = now
+		changes[i].By = by
+	}
+	t.History = append(slices.Clip(before.History), changes...)
+	t.UpdatedAt = now
+	return true
+}
+
+// Log appends a change that Diff cannot see, such as a move into or out of
+// the archive, and stamps UpdatedAt
+func (t *Task) Log(field, from, to, by string, now time.Time) {
+	t.History = append(slices.Clip(t.History), Change{At: now, By: by, Field: field, From: from, To: to})
+	t.UpdatedAt = now
+}
+
+// formatEstimate logs a missing estimate as an empty value
+func formatEstimate(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	return FormatEstimate(d)
+}
+
+// formatTime logs an unset time as an empty value
+func formatTime(t *time.Time) string {
+	if t == nil {
+		return ""
+	}
+	return t.Format(historyDateFormat)
+}
+
+// LastUpdated is when the task last changed, or when it was created
+func (t Task) LastUpdated() time.Time {
+	if t.UpdatedAt.IsZero() {
+		return t.CreatedAt
+	}
+	return t.UpdatedAt
+}
@@ -0,0 +1,35 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// Pomodoro is a focus session completed on a task
+type Pomodoro struct {
+	Start time.Time `json:"start"`
+	End   time.Time `json:"end"`
+}
+
+// LogPomodoro records a focus session that ran from start to end. The
+// session counts as tracked time too, unless the task's own timer was
+// already counting it.
+func (t *Task) LogPomodoro(start, end time.Time) {
+	t.Pomodoros = append(slices.Clip(t.Pomodoros), Pomodoro{Start: start, End: end})
+	if since, ok := t.RunningSince(); ok && since.Before(end) {
+		return
+	}
+	t.TimeEntries = append(slices.Clip(t.TimeEntries), TimeEntry{Start: start, End: &end})
+}
+
+// PomodorosOn counts the focus sessions completed on the local day of day
+func (t Task) PomodorosOn(day time.Time) int {
+	y, m, d := day.Local().Date()
+	n := 0
+	for _, p := range t.Pomodoros {
+		if py, pm, pd := p.End.Local().Date(); py == y && pm == m && pd == d {
+			n++
+		}
+	}
+	return n
+}
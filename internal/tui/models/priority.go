@@ -0,0 +1,71 @@
+package models
+
+import "fmt"
+
+// PriorityLevel indexes the priority scale, lowest first
+type PriorityLevel int
+
+// Levels of the default Low, Medium, High scale
+const (
+	Low PriorityLevel = iota
+	Medium
+	High
+)
+
+// Priority is one level of the priority scale
+type Priority struct {
+	Name  string
+	Color string // Hex or ANSI colour; empty picks one by position on the scale
+}
+
+// priorityScale is the live scale, lowest first
+var priorityScale = []Priority{{Name: "Low"}, {Name: "Medium"}, {Name: "High"}}
+
+// bandColors colour the low, middle and high thirds of the scale
+var bandColors = []string{"#44B556", "#FFA500", "#FF0000"}
+
+// SetPriorityScale replaces the priority levels, lowest first. Call it
+// before building the views.
+func SetPriorityScale(scale []Priority) {
+	priorityScale = scale
+}
+
+// PriorityScale returns the live priority levels, lowest first
+func PriorityScale() []Priority {
+	return priorityScale
+}
+
+// HighestPriority is the top level of the scale
+func HighestPriority() PriorityLevel {
+	return PriorityLevel(len(priorityScale) - 1)
+}
+
+// Valid reports whether p is a level of the scale
+func (p PriorityLevel) Valid() bool {
+	return p >= 0 && int(p) < len(priorityScale)
+}
+
+// Band maps p onto n equal parts of the scale, lowest first, so that
+// per-band icons and colours work whatever the scale's length
+func (p PriorityLevel) Band(n int) int {
+	if n <= 0 || len(priorityScale) == 0 {
+		return 0
+	}
+	level := min(max(int(p), 0), len(priorityScale)-1)
+	return level * n / len(priorityScale)
+}
+
+func (p PriorityLevel) String() string {
+	if !p.Valid() {
+		// Saved under a longer scale than the configured one
+		return fmt.Sprintf("Priority %d", int(p))
+	}
+	return priorityScale[p].Name
+}
+
+func (p PriorityLevel) Color() string {
+	if p.Valid() && priorityScale[p].Color != "" {
+		return priorityScale[p].Color
+	}
+	return bandColors[p.Band(len(bandColors))]
+}
@@ -0,0 +1,156 @@
+package models
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"time"
+)
+
+// Reminder is when to be reminded of a task: a while Before it is due, or
+// At a time of day on its due date
+type Reminder struct {
+	Before time.Duration `json:"before,omitempty"`
+	At     string        `json:"at,omitempty"` // "15:04" on the due date
+}
+
+// reminderClock matches a time of day such as "9:00" or "17:30"
+var reminderClock = regexp.MustCompile(`^\d{1,2}:\d{2}$`)
+
+// ParseReminder reads a reminder such as "15m", "1h before", "1d 2h" or
+// "at 09:00". Offsets count calendar days, unlike estimates; "0m" reminds
+// when the task falls due.
+func ParseReminder(s string) (Reminder, error) {
+	s = strings.ToLower(strings.TrimSpace(s))
+	if at, ok := strings.CutPrefix(s, "at "); ok || reminderClock.MatchString(s) {
+		if !ok {
+			at = s
+		}
+		t, err := time.Parse("15:04", strings.TrimSpace(at))
+		if err != nil {
+			return Reminder{}, fmt.Errorf("invalid reminder time %q", at)
+		}
+		return Reminder{At: t.Format("15:04")}, nil
+	}
+
+	offset := strings.TrimSpace(strings.TrimSuffix(s, "before"))
+	units := map[string]time.Duration{
+		"w": 7 * 24 * time.Hour,
+		"d": 24 * time.Hour,
+		"h": time.Hour,
+		"m": time.Minute,
+	}
+	var total time.Duration
+	rest := offset
+	for _, part := range estimatePart.FindAllStringSubmatch(offset, -1) {
+		n, err := strconv.ParseFloat(part[1], 64)
+		if err != nil {
+			return Reminder{}, fmt.Errorf("invalid reminder %q", s)
+		}
+		total += time.Duration(n * float64(units[part[2]]))
+		rest = strings.Replace(rest, part[0], "", 1)
+	}
+	if offset == "" || strings.TrimSpace(rest) != "" {
+		return Reminder{}, fmt.Errorf("invalid reminder %q (e.g. 15m, 1d, at 09:00)", s)
+	}
+	return Reminder{Before: total.Round(time.Minute)}, nil
+}
+
+// ParseReminders reads a comma separated list of reminders
+func ParseReminders(s string) ([]Reminder, error) {
+	var reminders []Reminder
+	for _, part := range strings.Split(s, ",") {
+		if strings.TrimSpace(part) == "" {
+			continue
+		}
+		r, err := ParseReminder(part)
+		if err != nil {
+			return nil, err
+		}
+		reminders = append(reminders, r)
+	}
+	return reminders, nil
+}
+
+// String writes the reminder the way ParseReminder reads it
+func (r Reminder) String() string {
+	if r.At != "" {
+		return "at " + r.At
+	}
+	if r.Before <= 0 {
+		return "0m"
+	}
+	d := r.Before
+	var parts []string
+	if days := d / (24 * time.Hour); days > 0 {
+		parts = append(parts, fmt.Sprintf("%dd", days))
+		d -= days * 24 * time.Hour
+	}
+	if hours := d / time.Hour; hours > 0 {
+		parts = append(parts, fmt.Sprintf("%dh", hours))
+		d -= hours * time.Hour
+	}
+	if minutes := d / time.Minute; minutes > 0 {
+		parts = append(parts, fmt.Sprintf("%dm", minutes))
+	}
+	return strings.Join(parts, " ") + " before"
+}
+
+// FormatReminders writes reminders as a comma separated list
+func FormatReminders(reminders []Reminder) string {
+	parts := make([]string, len(reminders))
+	for i, r := range reminders {
+		parts[i] = r.String()
+	}
+	return strings.Join(parts, ", ")
+}
+
+// DueDeadline is the moment a task due at due becomes overdue, or zero for a
+// task without a due date. Due dates are saved as midnight UTC, so their
+// date and clock are read as local time, and a due date without a time of
+// day lasts until the end of that day.
+func DueDeadline(due time.Time) time.Time {
+	if due.IsZero() {
+		return time.Time{}
+	}
+	if due.Hour() == 0 && due.Minute() == 0 {
+		return time.Date(due.Year(), due.Month(), due.Day()+1, 0, 0, 0, 0, time.Local)
+	}
+	return time.Date(due.Year(), due.Month(), due.Day(), due.Hour(), due.Minute(), 0, 0, time.Local)
+}
+
+// Time is when the reminder goes off for a task due at due. Offsets count
+// back from the moment the task becomes overdue.
+func (r Reminder) Time(due time.Time) time.Time {
+	deadline := DueDeadline(due)
+	if r.At == "" {
+		return deadline.Add(-r.Before)
+	}
+	at, err := time.Parse("15:04", r.At)
+	if err != nil {
+		return deadline
+	}
+	return time.Date(due.Year(), due.Month(), due.Day(), at.Hour(), at.Minute(), 0, 0, time.Local)
+}
+
+// DueBefore reports whether the task becomes overdue before at. Tasks
+// without a due date never do.
+func (t Task) DueBefore(at time.Time) bool {
+	return !t.DueDate.IsZero() && DueDeadline(t.DueDate).Before(at)
+}
+
+// RemindersBetween returns the reminders that go off after from and up to
+// to. Done and trashed tasks, and tasks without a due date, remind of nothing.
+func (t Task) RemindersBetween(from, to time.Time) []Reminder {
+	if t.Completed || t.Trashed() || t.DueDate.IsZero() {
+		return nil
+	}
+	var due []Reminder
+	for _, r := range t.Reminders {
+		if at := r.Time(t.DueDate); at.After(from) && !at.After(to) {
+			due = append(due, r)
+		}
+	}
+	return due
+}
@@ -0,0 +1,75 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+func TestReminderTimeWestOfUTC(t *testing.T) {
+	newYork, err := time.LoadLocation("America/New_York")
+	if err != nil {
+		t.Skip(err)
+	}
+	local := time.Local
+	time.Local = newYork
+	defer func() { time.Local = local }()
+
+	// Due dates are saved as midnight UTC; one without a time of day is due
+	// until the end of that day
+	day := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	timed := time.Date(2026, 10, 20, 14, 30, 0, 0, time.UTC)
+	tests := []struct {
+		reminder string
+		due      time.Time
+		want     time.Time
+	}{
+		{"at 09:00", day, time.Date(2026, 10, 20, 9, 0, 0, 0, newYork)},
+		{"1d", day, time.Date(2026, 10, 20, 0, 0, 0, 0, newYork)},
+		{"1h", day, time.Date(2026, 10, 20, 23, 0, 0, 0, newYork)},
+		{"0m", day, time.Date(2026, 10, 21, 0, 0, 0, 0, newYork)},
+		{"1h", timed, time.Date(2026, 10, 20, 13, 30, 0, 0, newYork)},
+		{"0m", timed, time.Date(2026, 10, 20, 14, 30, 0, 0, newYork)},
+	}
+	for _, tt := range tests {
+		r, err := ParseReminder(tt.reminder)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if got := r.Time(tt.due); !got.Equal(tt.want) {
+			t.Errorf("%s before %v: Time = %v, want %v", tt.reminder, tt.due, got, tt.want)
+		}
+	}
+}
+
+func TestReminderStringRoundTrips(t *testing.T) {
+	for _, r := range []Reminder{
+		{},
+		{Before: 15 * time.Minute},
+		{Before: time.Hour},
+		{Before: 24 * time.Hour},
+		{Before: 26*time.Hour + 30*time.Minute},
+		{Before: 14 * 24 * time.Hour},
+		{At: "09:00"},
+		{At: "17:30"},
+	} {
+		got, err := ParseReminder(r.String())
+		if err != nil {
+			t.Errorf("%+v: ParseReminder(%q): %v", r, r.String(), err)
+			continue
+		}
+		if got != r {
+			t.Errorf("%+v: read back %q as %+v", r, r.String(), got)
+		}
+	}
+
+	reminders := []Reminder{{}, {Before: time.Hour}, {At: "09:00"}}
+	got, err := ParseReminders(FormatReminders(reminders))
+	if err != nil || len(got) != len(reminders) {
+		t.Fatalf("ParseReminders(%q) = %v, %v", FormatReminders(reminders), got, err)
+	}
+	for i := range got {
+		if got[i] != reminders[i] {
+			t.Errorf("reminder %d read back as %+v, want %+v", i, got[i], reminders[i])
+		}
+	}
+}
@@ -0,0 +1,104 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// Status is a workflow state a task can be in
+type Status struct {
+	ID    string
+	Name  string
+	Color string // Hex or ANSI colour; empty uses the theme's text colour
+	Icon  string
+	Done  bool     // Tasks in this state count as completed
+	Next  []string // States a task may move to; the first is where it advances
+}
+
+// Workflow is the ordered list of states tasks move through
+type Workflow struct {
+	States  []Status
+	Initial string // State new and reopened tasks start in
+}
+
+// Status returns the state with the given id. Unknown ids, such as states
+// removed from the config, come back as a plain state named after the id.
+func (w Workflow) Status(id string) Status {
+	for _, s := range w.States {
+		if s.ID == id {
+			return s
+		}
+	}
+	return Status{ID: id, Name: id}
+}
+
+// Index returns the position of the state in the workflow, or -1
+func (w Workflow) Index(id string) int {
+	return slices.IndexFunc(w.States, func(s Status) bool { return s.ID == id })
+}
+
+// Next returns the state a task in the given state advances to
+func (w Workflow) Next(id string) (Status, bool) {
+	s := w.Status(id)
+	if len(s.Next) == 0 {
+		// Unknown states restart the workflow
+		if w.Index(id) < 0 {
+			return w.Status(w.Initial), true
+		}
+		return Status{}, false
+	}
+	return w.Status(s.Next[0]), true
+}
+
+// Done returns the state completing a task moves it to: the first done
+// state in the workflow, whatever the task's state allows. Other done
+// states, such as a cancelled one, are only reached by moving the task.
+func (w Workflow) Done() (Status, bool) {
+	if i := slices.IndexFunc(w.States, func(s Status) bool { return s.Done }); i >= 0 {
+		return w.States[i], true
+	}
+	return Status{}, false
+}
+
+// CanMove reports whether a task may go straight from one state to another
+func (w Workflow) CanMove(from, to string) bool {
+	if w.Index(to) < 0 {
+		return false
+	}
+	if w.Index(from) < 0 {
+		return true
+	}
+	return slices.Contains(w.Status(from).Next, to)
+}
+
+// Migrate fills in the state of a task saved before workflows existed and
+// keeps Completed in line with the state. It reports whether it changed the task.
+func (w Workflow) Migrate(t *Task) bool {
+	if t.Status == "" {
+		t.Status = w.Initial
+		if t.Completed {
+			if done, ok := w.Done(); ok {
+				t.Status = done.ID
+			}
+		}
+		return true
+	}
+	if w.Index(t.Status) < 0 || t.Completed == w.Status(t.Status).Done {
+		return false
+	}
+	t.Completed = !t.Completed
+	return true
+}
+
+// SetStatus moves the task to s, stamping when it was done
+func (t *Task) SetStatus(s Status, now time.Time) {
+	t.Status = s.ID
+	if s.Done == t.Completed {
+		return
+	}
+	t.Completed = s.Done
+	t.CompletedAt = nil
+	if s.Done {
+		t.CompletedAt = &now
+	}
+}
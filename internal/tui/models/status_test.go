@@ -0,0 +1,66 @@
+package models
+
+import (
+	"testing"
+	"time"
+)
+
+// testWorkflow runs todo → doing → done, and any state can be cancelled
+var testWorkflow = Workflow{
+	States: []Status{
+		{ID: "todo", Next: []string{"doing", "cancelled"}},
+		{ID: "doing", Next: []string{"done", "cancelled"}},
+		{ID: "cancelled", Done: true, Next: []string{"todo"}},
+		{ID: "done", Done: true, Next: []string{"todo"}},
+	},
+	Initial: "todo",
+}
+
+func TestWorkflowDoneIsTheFirstDoneState(t *testing.T) {
+	w := testWorkflow
+	w.States = append([]Status{w.States[3]}, w.States[:3]...)
+	if done, ok := w.Done(); !ok || done.ID != "done" {
+		t.Errorf("Done = %q, %v; want done", done.ID, ok)
+	}
+	if _, ok := (Workflow{States: []Status{{ID: "todo"}}}).Done(); ok {
+		t.Error("a workflow without done states has a done state")
+	}
+}
+
+func TestWorkflowMigrate(t *testing.T) {
+	// testWorkflow lists cancelled first; Migrate uses the first done state
+	tests := []struct {
+		name          string
+		status        string
+		completed     bool
+		wantStatus    string
+		wantCompleted bool
+		changed       bool
+	}{
+		{"pending without a state", "", false, "todo", false, true},
+		{"completed without a state", "", true, "cancelled", true, true},
+		{"done state not completed", "done", false, "done", true, true},
+		{"open state completed", "doing", true, "doing", false, true},
+		{"in line", "doing", false, "doing", false, false},
+		{"unknown state", "gone", true, "gone", true, false},
+	}
+	for _, tt := range tests {
+		task := NewTask(tt.name, "", time.Time{}, Low)
+		task.Status, task.Completed = tt.status, tt.completed
+		if changed := testWorkflow.Migrate(&task); changed != tt.changed {
+			t.Errorf("%s: changed = %v, want %v", tt.name, changed, tt.changed)
+		}
+		if task.Status != tt.wantStatus || task.Completed != tt.wantCompleted {
+			t.Errorf("%s: got %q completed %v, want %q completed %v",
+				tt.name, task.Status, task.Completed, tt.wantStatus, tt.wantCompleted)
+		}
+	}
+}
+
+func TestWorkflowNext(t *testing.T) {
+	for from, want := range map[string]string{"todo": "doing", "doing": "done", "done": "todo", "gone": "todo"} {
+		if next, ok := testWorkflow.Next(from); !ok || next.ID != want {
+			t.Errorf("Next(%q) = %q, %v; want %q", from, next.ID, ok, want)
+		}
+	}
+}
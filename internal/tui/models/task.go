@@ -7,24 +7,29 @@ import (
 )
 
 type Task struct {
-	ID          string        `json:"id"`
-	Title       string        `json:"title"`
-	Description string        `json:"description"`
-	DueDate     time.Time     `json:"due_date"`
-	Priority    PriorityLevel `json:"priority"`
-	Completed   bool          `json:"completed"`
-	CreatedAt   time.Time     `json:"created_at"`
+	ID          string          `json:"id"`
+	Title       string          `json:"title"`
+	Description string          `json:"description"`
+	DueDate     time.Time       `json:"due_date"`
+	Priority    PriorityLevel   `json:"priority"`
+	Completed   bool            `json:"completed"` // Mirrors whether Status is a done state
+	Status      string          `json:"status,omitempty"`
+	Tags        []string        `json:"tags,omitempty"`
+	Estimate    time.Duration   `json:"estimate,omitempty"` // Expected effort; 0 when not estimated
+	Reminders   []Reminder      `json:"reminders,omitempty"`
+	Checklist   []ChecklistItem `json:"checklist,omitempty"` // Steps in the order they are done
+	CreatedAt   time.Time       `json:"created_at"`
+	UpdatedAt   time.Time       `json:"updated_at"`             // Zero on tasks saved before it was tracked
+	CompletedAt *time.Time      `json:"completed_at,omitempty"` // When the task was last marked done
+	DeletedAt   *time.Time      `json:"deleted_at,omitempty"`   // Set while the task is in the trash
+	DeferUntil  *time.Time      `json:"defer_until,omitempty"`  // The task lists hide the task until then
+	History     []Change        `json:"history,omitempty"`      // Field changes, oldest first
+	TimeEntries []TimeEntry     `json:"time_entries,omitempty"` // Time tracked on the task, oldest first
+	Pomodoros   []Pomodoro      `json:"pomodoros,omitempty"`    // Completed focus sessions, oldest first
 }
 
-type PriorityLevel int
-
-const (
-	Low PriorityLevel = iota
-	Medium
-	High
-)
-
 func NewTask(title string, description string, dueDate time.Time, priority PriorityLevel) Task {
+	now := time.Now()
 	return Task{
 		ID:          uuid.New().String(),
 		Title:       title,
@@ -32,14 +37,18 @@ func NewTask(title string, description string, dueDate time.Time, priority Prior
 		DueDate:     dueDate,
 		Priority:    priority,
 		Completed:   false,
-		CreatedAt:   time.Now(),
+		CreatedAt:   now,
+		UpdatedAt:   now,
 	}
 }
 
-func (p PriorityLevel) String() string {
-	return [...]string{"Low", "Medium", "High"}[p]
+// Trashed reports whether the task is in the trash
+func (t Task) Trashed() bool {
+	return t.DeletedAt != nil
 }
 
-func (p PriorityLevel) Color() string {
-	return [...]string{"#44B556", "#FFA500", "#FF0000"}[p]
+// Deferred reports whether the task is hidden from the task lists until a
+// later time
+func (t Task) Deferred(now time.Time) bool {
+	return t.DeferUntil != nil && now.Before(*t.DeferUntil)
 }
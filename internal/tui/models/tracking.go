@@ -0,0 +1,126 @@
+package models
+
+import (
+	"slices"
+	"time"
+)
+
+// TimeEntry is a span of time spent on a task
+type TimeEntry struct {
+	Start time.Time  `json:"start"`
+	End   *time.Time `json:"end,omitempty"` // Nil while the timer runs
+}
+
+// Running reports whether the entry's timer is still going
+func (e TimeEntry) Running() bool {
+	return e.End == nil
+}
+
+// Duration is the length of the entry, up to now while it runs
+func (e TimeEntry) Duration(now time.Time) time.Duration {
+	end := now
+	if e.End != nil {
+		end = *e.End
+	}
+	return max(end.Sub(e.Start), 0)
+}
+
+// RunningSince returns when the task's running timer started
+func (t Task) RunningSince() (time.Time, bool) {
+	for _, e := range t.TimeEntries {
+		if e.Running() {
+			return e.Start, true
+		}
+	}
+	return time.Time{}, false
+}
+
+// StartTimer opens a time entry at now unless one is already running
+func (t *Task) StartTimer(now time.Time) {
+	if _, ok := t.RunningSince(); ok {
+		return
+	}
+	t.TimeEntries = append(slices.Clip(t.TimeEntries), TimeEntry{Start: now})
+}
+
+// StopTimer closes the running time entry at now. It reports whether one was running.
+func (t *Task) StopTimer(now time.Time) bool {
+	i := slices.IndexFunc(t.TimeEntries, TimeEntry.Running)
+	if i < 0 {
+		return false
+	}
+	// Copy so that snapshots sharing the entries keep their running timer
+	t.TimeEntries = slices.Clone(t.TimeEntries)
+	end := now
+	if end.Before(t.TimeEntries[i].Start) {
+		end = t.TimeEntries[i].Start
+	}
+	t.TimeEntries[i].End = &end
+	return true
+}
+
+// Tracked is the total time logged on the task, up to now for a running timer
+func (t Task) Tracked(now time.Time) time.Duration {
+	var total time.Duration
+	for _, e := range t.TimeEntries {
+		total += e.Duration(now)
+	}
+	return total
+}
+
+// TrackedByDay splits the logged time by local calendar day. Entries that
+// run past midnight count towards both days.
+func (t Task) TrackedByDay(now time.Time) map[time.Time]time.Duration {
+	days := make(map[time.Time]time.Duration)
+	for _, e := range t.TimeEntries {
+		start := e.Start.Local()
+		end := start.Add(e.Duration(now))
+		for start.Before(end) {
+			day := time.Date(start.Year(), start.Month(), start.Day(), 0, 0, 0, 0, time.Local)
+			next := day.AddDate(0, 0, 1)
+			stop := next
+			if end.Before(next) {
+				stop = end
+			}
+			days[day] += stop.Sub(start)
+			start = next
+		}
+	}
+	return days
+}
+
+// TrackedByTag totals the time logged on the tasks under each of their tags
+func TrackedByTag(tasks []Task, now time.Time) map[string]time.Duration {
+	tags := make(map[string]time.Duration)
+	for _, task := range tasks {
+		tracked := task.Tracked(now)
+		if tracked == 0 {
+			continue
+		}
+		for _, tag := range task.Tags {
+			tags[tag] += tracked
+		}
+	}
+	return tags
+}
+
+// StopStrayTimers keeps only the most recently started timer running across
+// tasks. Older ones are closed when the newer one started, as if it had been
+// started the usual way. It reports whether any task changed.
+func StopStrayTimers(tasks []Task) bool {
+	latest := -1
+	var latestStart time.Time
+	for i, task := range tasks {
+		if start, ok := task.RunningSince(); ok && (latest < 0 || start.After(latestStart)) {
+			latest, latestStart = i, start
+		}
+	}
+
+	changed := false
+	for i := range tasks {
+		if i != latest && tasks[i].StopTimer(latestStart) {
+			changed = true
+		}
+	}
+	return changed
+}
@@ -1,12 +1,20 @@
 package tui
 
 import (
+	"fmt"
+	"os"
+	"os/user"
 	"slices"
+	"strings"
+	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sabry-awad97/task-manager/internal/config"
 	"github.com/sabry-awad97/task-manager/internal/storage"
 	"github.com/sabry-awad97/task-manager/internal/tui/models"
 	"github.com/sabry-awad97/task-manager/internal/tui/views"
+	"github.com/sabry-awad97/task-manager/internal/tui/zone"
 )
 
 type View int
@@ -16,6 +24,16 @@ const (
 	FormView
 	DetailView
 	ErrorView
+	BoardView
+	CalendarView
+	AgendaView
+	TrashView
+	ArchiveView
+	MatrixView
+	TimeLogView
+	EffortView
+	FocusView
+	DeferredView
 )
 
 func (v View) String() string {
@@ -26,6 +44,28 @@ func (v View) String() string {
 		return "form"
 	case DetailView:
 		return "detail"
+	case ErrorView:
+		return "error"
+	case BoardView:
+		return "board"
+	case CalendarView:
+		return "calendar"
+	case AgendaView:
+		return "agenda"
+	case TrashView:
+		return "trash"
+	case ArchiveView:
+		return "archive"
+	case MatrixView:
+		return "matrix"
+	case TimeLogView:
+		return "time_log"
+	case EffortView:
+		return "effort"
+	case FocusView:
+		return "focus"
+	case DeferredView:
+		return "deferred"
 	default:
 		return "unknown"
 	}
@@ -33,64 +73,874 @@ func (v View) String() string {
 
 type rootModel struct {
 	currentView   View
+	listView      View // Layout to return to from detail, form and error views
+	formReturn    View // View to return to when the form closes
+	focusReturn   View // View to return to when the focus view closes
 	width, height int
 	mainView      views.MainViewModel
+	boardView     views.BoardViewModel
+	calendarView  views.CalendarViewModel
+	agendaView    views.AgendaViewModel
+	trashView     views.TrashViewModel
+	archiveView   views.ArchiveViewModel
+	matrixView    views.MatrixViewModel
+	timeLogView   views.TimeLogViewModel
+	effortView    views.EffortViewModel
+	deferredView  views.DeferredViewModel
+	focusView     views.FocusViewModel
 	formView      views.FormViewModel
 	detailView    views.DetailViewModel
 	store         *storage.JSONStore
-	tasks         []models.Task
+	archive       *storage.JSONStore // Archived tasks, kept out of memory until the archive is opened
+	tasks         []models.Task      // Every task, trashed ones included
+	active        []models.Task      // Tasks outside the trash that are not deferred, in table order
+	deferred      []models.Task      // Tasks snoozed until a later time
+	undo          []undoStep         // The changes of the last bulk actions, oldest first
 	errorView     views.ErrorViewModel
+	palette       views.PaletteModel
+	paletteOpen   bool
+	help          views.HelpModel
+	helpOpen      bool
+	confirm       views.ConfirmModel
+	confirmOpen   bool
+	keys          views.KeyMap
+	workflow      models.Workflow
+	user          string    // Named in the activity log of the tasks this session changes
+	ticking       bool      // A timer tick is scheduled to redraw the running timer
+	wakeAt        time.Time // When the next deferred task shows again; a wake tick is scheduled for it
+	bell          bool      // The next frame rings the terminal bell
+	pomodoro      views.PomodoroSettings
 }
 
-func NewRootModel() rootModel {
+// maxUndo bounds how many bulk actions can be undone
+const maxUndo = 20
+
+// NewRootModel builds the app from cfg. It fails when the configured key
+// bindings or themes are invalid.
+func NewRootModel(cfg config.Config) (rootModel, error) {
+	keys, err := views.NewKeyMap(cfg.Keymap, cfg.Keys)
+	if err != nil {
+		return rootModel{}, err
+	}
+
+	scale := make([]models.Priority, len(cfg.Priorities))
+	for i, p := range cfg.Priorities {
+		scale[i] = models.Priority{Name: p.Name, Color: p.Color}
+	}
+	models.SetPriorityScale(scale)
+	models.SetWorkday(time.Duration(cfg.WorkdayHours * float64(time.Hour)))
+
+	theme, err := views.LoadThemes(cfg.Theme, cfg.Themes)
+	if err != nil {
+		return rootModel{}, err
+	}
+	views.SetTheme(theme)
+	views.SetDisplay(views.Display{
+		ASCII:        cfg.ASCII,
+		NoColor:      cfg.NoColor,
+		ScreenReader: cfg.ScreenReader,
+	})
+	// Views read the key map and the workflow when they are built
+	views.SetKeyMap(keys)
+	views.SetWorkflow(cfg.Statuses, cfg.InitialStatus)
+	workflow := views.CurrentWorkflow()
+
 	store := storage.NewJSONStore("tasks.json")
 	tasks, _ := store.Load() // Load existing tasks
 
-	mainView := views.NewMainViewModel()
-	mainView.UpdateTasks(tasks)
+	// Give tasks saved with only a completed flag a workflow state, and
+	// turn Markdown task lists in imported descriptions into checklists
+	migrated := false
+	for i := range tasks {
+		if workflow.Migrate(&tasks[i]) {
+			migrated = true
+		}
+		if tasks[i].ImportChecklist() {
+			migrated = true
+		}
+	}
+	// Only one timer may run; a crash or a second instance could leave more
+	if models.StopStrayTimers(tasks) {
+		migrated = true
+	}
+	if migrated {
+		store.Save(tasks)
+	}
 
-	return rootModel{
-		currentView: MainView,
-		mainView:    mainView,
-		formView:    views.NewFormViewModel(),
-		store:       store,
-		tasks:       tasks,
+	if cfg.TrashDays > 0 {
+		maxAge := time.Duration(cfg.TrashDays) * 24 * time.Hour
+		if kept, purged := purgeTrash(tasks, maxAge, time.Now()); purged {
+			tasks = kept
+			store.Save(tasks)
+		}
 	}
+
+	archive := storage.NewJSONStore("archive.json")
+	if cfg.ArchiveDays > 0 {
+		maxAge := time.Duration(cfg.ArchiveDays) * 24 * time.Hour
+		if kept, archived := archiveCompleted(tasks, maxAge, time.Now()); len(archived) > 0 {
+			// Only drop the tasks once the archive holds them
+			if err := archive.Append(archived); err == nil {
+				tasks = kept
+				store.Save(tasks)
+			}
+		}
+	}
+
+	landing := MainView
+	if cfg.LandingView == config.LandingAgenda {
+		landing = AgendaView
+	}
+
+	m := rootModel{
+		currentView:  landing,
+		listView:     landing,
+		mainView:     views.NewMainViewModel(cfg.Highlight, cfg.Columns),
+		boardView:    views.NewBoardViewModel(),
+		calendarView: views.NewCalendarViewModel(),
+		agendaView:   views.NewAgendaViewModel(),
+		trashView:    views.NewTrashViewModel(cfg.TrashDays),
+		archiveView:  views.NewArchiveViewModel(),
+		matrixView:   views.NewMatrixViewModel(importantPriority(cfg), cfg.UrgentWithin),
+		effortView:   views.NewEffortViewModel(),
+		deferredView: views.NewDeferredViewModel(),
+		formView:     views.NewFormViewModel(),
+		store:        store,
+		archive:      archive,
+		tasks:        tasks,
+		keys:         keys,
+		workflow:     workflow,
+		user:         currentUser(),
+		pomodoro: views.PomodoroSettings{
+			Work:           cfg.Pomodoro.Work,
+			Break:          cfg.Pomodoro.Break,
+			LongBreak:      cfg.Pomodoro.LongBreak,
+			LongBreakEvery: cfg.Pomodoro.LongBreakEvery,
+			Bell:           cfg.Pomodoro.Bell,
+		},
+	}
+	m.refreshViews()
+	// A timer left running when the app last closed keeps counting from its start
+	_, m.ticking = runningTimer(m.tasks)
+	m.wakeAt = nextWake(m.tasks, time.Now())
+
+	return m, nil
 }
 
-func (m rootModel) Init() tea.Cmd {
+// purgeTrash drops tasks that have been in the trash longer than maxAge
+func purgeTrash(tasks []models.Task, maxAge time.Duration, now time.Time) ([]models.Task, bool) {
+	kept := slices.DeleteFunc(slices.Clone(tasks), func(task models.Task) bool {
+		return task.Trashed() && now.Sub(*task.DeletedAt) > maxAge
+	})
+	return kept, len(kept) != len(tasks)
+}
+
+// importantPriority is the lowest level the Eisenhower matrix counts as
+// important: the configured one, or the top of the scale
+func importantPriority(cfg config.Config) models.PriorityLevel {
+	for i, p := range cfg.Priorities {
+		if strings.EqualFold(p.Name, cfg.ImportantPriority) {
+			return models.PriorityLevel(i)
+		}
+	}
+	return models.HighestPriority()
+}
+
+// archiveCompleted splits off the tasks completed more than maxAge ago.
+// Trashed tasks and tasks with no completion time stay where they are.
+func archiveCompleted(tasks []models.Task, maxAge time.Duration, now time.Time) (kept, archived []models.Task) {
+	for _, task := range tasks {
+		if task.Completed && !task.Trashed() && task.CompletedAt != nil && now.Sub(*task.CompletedAt) > maxAge {
+			archived = append(archived, task)
+		} else {
+			kept = append(kept, task)
+		}
+	}
+	return kept, archived
+}
+
+// currentUser names the person running the app, for the activity log
+func currentUser() string {
+	if u, err := user.Current(); err == nil && u.Username != "" {
+		return u.Username
+	}
+	return os.Getenv("USER")
+}
+
+// record logs how the task at i differs from before in its activity log
+func (m *rootModel) record(i int, before models.Task, now time.Time) {
+	m.tasks[i].Record(before, m.user, now)
+}
+
+// undoStep holds how one bulk action changed each task it touched, by task ID
+type undoStep map[string]taskChange
+
+// taskChange is a task just before and just after a bulk action
+type taskChange struct {
+	before, after models.Task
+}
+
+// snapshot copies the tasks named by ids, by ID, to pass to pushUndo once
+// they have changed
+func (m rootModel) snapshot(ids []string) map[string]models.Task {
+	tasks := make(map[string]models.Task, len(ids))
+	for _, task := range m.tasks {
+		if slices.Contains(ids, task.ID) {
+			task.Tags = slices.Clone(task.Tags)
+			tasks[task.ID] = task
+		}
+	}
+	return tasks
+}
+
+// pushUndo records how the tasks in before have changed so the change can
+// be reverted
+func (m *rootModel) pushUndo(before map[string]models.Task) {
+	step := make(undoStep, len(before))
+	for _, task := range m.tasks {
+		if b, ok := before[task.ID]; ok {
+			step[task.ID] = taskChange{before: b, after: task}
+		}
+	}
+	m.undo = append(m.undo, step)
+	if len(m.undo) > maxUndo {
+		m.undo = m.undo[1:]
+	}
+}
+
+// popUndo reverts the last bulk action on the tasks it touched. Changes
+// made since, to other tasks or to other fields, are kept.
+func (m *rootModel) popUndo() {
+	step := m.undo[len(m.undo)-1]
+	m.undo = m.undo[:len(m.undo)-1]
+
+	now := time.Now()
+	for i := range m.tasks {
+		change, ok := step[m.tasks[i].ID]
+		if !ok {
+			continue
+		}
+		before := m.tasks[i]
+		revert(&m.tasks[i], change.before, change.after)
+		m.record(i, before, now)
+	}
+}
+
+// revert puts back the fields a bulk action changed from before to after,
+// unless they were changed again since
+func revert(task *models.Task, before, after models.Task) {
+	if task.Status == after.Status && task.Completed == after.Completed {
+		task.Status, task.Completed, task.CompletedAt = before.Status, before.Completed, before.CompletedAt
+	}
+	if task.Priority == after.Priority {
+		task.Priority = before.Priority
+	}
+	if slices.Equal(task.Tags, after.Tags) {
+		task.Tags = slices.Clone(before.Tags)
+	}
+	if task.DueDate.Equal(after.DueDate) {
+		task.DueDate = before.DueDate
+	}
+	if sameTime(task.DeferUntil, after.DeferUntil) {
+		task.DeferUntil = before.DeferUntil
+	}
+	if sameTime(task.DeletedAt, after.DeletedAt) {
+		task.DeletedAt = before.DeletedAt
+	}
+	// Timers the action stopped stay stopped, keeping the time they tracked
+}
+
+// sameTime reports whether two optional times are both unset or equal
+func sameTime(a, b *time.Time) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.Equal(*b)
+}
+
+// applyBulk performs a bulk action on the tasks it names
+func (m *rootModel) applyBulk(msg views.BulkActionMsg) {
+	ids := make(map[string]bool, len(msg.TaskIDs))
+	for _, id := range msg.TaskIDs {
+		ids[id] = true
+	}
+
+	now := time.Now()
+	for i := range m.tasks {
+		task := &m.tasks[i]
+		if !ids[task.ID] {
+			continue
+		}
+		before := *task
+		switch msg.Action {
+		case views.BulkAdvance:
+			if next, ok := m.workflow.Next(task.Status); ok {
+				task.SetStatus(next, now)
+			}
+		case views.BulkComplete:
+			if done, ok := m.workflow.Done(); ok && !task.Completed {
+				task.SetStatus(done, now)
+			}
+		case views.BulkSetPriority:
+			task.Priority = msg.Priority
+		case views.BulkAddTag:
+			if !slices.Contains(task.Tags, msg.Tag) {
+				task.Tags = append(task.Tags, msg.Tag)
+			}
+		case views.BulkReschedule:
+			// Keep the time of day the task was due at
+			d := msg.DueDate
+			task.DueDate = time.Date(d.Year(), d.Month(), d.Day(),
+				task.DueDate.Hour(), task.DueDate.Minute(), 0, 0, d.Location())
+		case views.BulkSnooze:
+			task.DeferUntil = nil
+			if !msg.Until.IsZero() {
+				until := msg.Until
+				task.DeferUntil = &until
+			}
+		}
+		m.record(i, before, now)
+	}
+}
+
+// refreshViews pushes the tasks outside the trash into every view that lists
+// tasks, the deferred ones into the deferred view and the trashed ones into
+// the trash view
+func (m *rootModel) refreshViews() {
+	now := time.Now()
+	var active, deferred, trashed []models.Task
+	for _, task := range m.tasks {
+		switch {
+		case task.Trashed():
+			trashed = append(trashed, task)
+		case task.Deferred(now):
+			deferred = append(deferred, task)
+		default:
+			active = append(active, task)
+		}
+	}
+	m.active = active
+	m.deferred = deferred
+	tracked := m.tracked()
+
+	// The table sorts the active tasks in place; the detail view steps through them in that order
+	m.mainView.UpdateTasks(m.active)
+	m.boardView.UpdateTasks(m.active)
+	m.calendarView.UpdateTasks(m.active)
+	m.agendaView.UpdateTasks(m.active)
+	m.matrixView.UpdateTasks(m.active)
+	m.deferredView.UpdateTasks(m.deferred)
+	m.detailView.UpdateTasks(m.detailTasks())
+	m.timeLogView.UpdateTasks(tracked)
+	m.effortView.UpdateTasks(tracked)
+	m.focusView.UpdateTasks(tracked)
+	m.trashView.UpdateTasks(trashed)
+	if m.currentView == DetailView && m.detailView.ShouldReturn() {
+		m.currentView = m.listView
+	}
+}
+
+// detailTasks are the tasks the detail view steps through: those of the
+// list it was opened from
+func (m rootModel) detailTasks() []models.Task {
+	switch m.listView {
+	case DeferredView:
+		return m.deferred
+	case EffortView:
+		return m.tracked()
+	}
+	return m.active
+}
+
+// tracked are the tasks whose time counts: the active and the deferred ones
+func (m rootModel) tracked() []models.Task {
+	// Deferred tasks still count towards tracked time
+	return append(slices.Clone(m.active), m.deferred...)
+}
+
+// openConfirm asks the user to confirm msg.Confirm before it is sent
+func (m *rootModel) openConfirm(msg views.ConfirmMsg) tea.Cmd {
+	m.confirm = views.NewConfirmModel(msg, m.width, m.height)
+	m.confirmOpen = true
+	return m.confirm.Init()
+}
+
+// setTrashed moves the tasks named by ids into or out of the trash
+func (m *rootModel) setTrashed(ids []string, trashed bool) {
+	now := time.Now()
+	for i := range m.tasks {
+		if !slices.Contains(ids, m.tasks[i].ID) {
+			continue
+		}
+		before := m.tasks[i]
+		if trashed {
+			m.tasks[i].DeletedAt = &now
+		} else {
+			m.tasks[i].DeletedAt = nil
+		}
+		m.record(i, before, now)
+	}
+}
+
+// loadArchive reads the archived tasks into the archive view
+func (m *rootModel) loadArchive() tea.Cmd {
+	archived, err := m.archive.Load()
+	if err != nil {
+		return func() tea.Msg { return err }
+	}
+	m.archiveView.UpdateTasks(archived)
 	return nil
 }
 
+// openForm shows the form, returning to the detail view when it was opened
+// from there and to the list otherwise
+func (m *rootModel) openForm() {
+	m.formReturn = m.listView
+	if m.currentView == DetailView {
+		m.formReturn = DetailView
+	}
+	m.currentView = FormView
+}
+
+func (m rootModel) Init() tea.Cmd {
+	var cmds []tea.Cmd
+	if m.ticking {
+		cmds = append(cmds, timerTick())
+	}
+	if !m.wakeAt.IsZero() {
+		cmds = append(cmds, wakeTick(m.wakeAt))
+	}
+	return tea.Batch(cmds...)
+}
+
+// wakeMsg shows the tasks deferred until its time again
+type wakeMsg time.Time
+
+// wakeTick schedules a wake message for at
+func wakeTick(at time.Time) tea.Cmd {
+	return tea.Tick(time.Until(at), func(time.Time) tea.Msg {
+		return wakeMsg(at)
+	})
+}
+
+// nextWake is when the first of the deferred tasks shows again, or zero
+// when none is deferred
+func nextWake(tasks []models.Task, now time.Time) time.Time {
+	var next time.Time
+	for _, task := range tasks {
+		if task.Trashed() || !task.Deferred(now) {
+			continue
+		}
+		if next.IsZero() || task.DeferUntil.Before(next) {
+			next = *task.DeferUntil
+		}
+	}
+	return next
+}
+
+// scheduleWake schedules a wake tick for the first deferred task unless one
+// is already scheduled for it
+func (m *rootModel) scheduleWake() tea.Cmd {
+	next := nextWake(m.tasks, time.Now())
+	if next.IsZero() || next.Equal(m.wakeAt) {
+		return nil
+	}
+	m.wakeAt = next
+	return wakeTick(next)
+}
+
+// bellDoneMsg stops adding the bell to frames
+type bellDoneMsg struct{}
+
+// bellFrame is how long the bell stays in the view, enough for the renderer
+// to draw at least one frame with it
+const bellFrame = 100 * time.Millisecond
+
+// timerTickMsg redraws the running timer
+type timerTickMsg time.Time
+
+// timerTick schedules the next redraw of the running timer, on the second
+func timerTick() tea.Cmd {
+	return tea.Every(time.Second, func(t time.Time) tea.Msg {
+		return timerTickMsg(t)
+	})
+}
+
+// runningTimer returns the index of the task whose timer is running
+func runningTimer(tasks []models.Task) (int, bool) {
+	for i, task := range tasks {
+		if _, ok := task.RunningSince(); ok {
+			return i, true
+		}
+	}
+	return -1, false
+}
+
+// toggleTimer stops the running timer and, unless it belonged to the task
+// with id, starts that task's timer. It returns the tick that redraws the
+// new timer, if one is needed.
+func (m *rootModel) toggleTimer(id string) tea.Cmd {
+	now := time.Now()
+	stopped := ""
+	if i, ok := runningTimer(m.tasks); ok {
+		m.tasks[i].StopTimer(now)
+		stopped = m.tasks[i].ID
+	}
+	if stopped == id {
+		return nil
+	}
+	for i := range m.tasks {
+		if m.tasks[i].ID == id {
+			m.tasks[i].StartTimer(now)
+			break
+		}
+	}
+	return m.startTicking()
+}
+
+// stopTimers stops the timers of the tasks named by ids
+func (m *rootModel) stopTimers(ids []string) {
+	now := time.Now()
+	for i := range m.tasks {
+		if slices.Contains(ids, m.tasks[i].ID) {
+			m.tasks[i].StopTimer(now)
+		}
+	}
+}
+
+// startTicking schedules timer ticks unless they are already running
+func (m *rootModel) startTicking() tea.Cmd {
+	if m.ticking {
+		return nil
+	}
+	m.ticking = true
+	return timerTick()
+}
+
+// Update handles msg and then schedules a wake tick if the tasks it changed
+// defer one to a new time, so no change to the list can leave one unscheduled
 func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	next, cmd := m.update(msg)
+	root := next.(rootModel)
+	return root, tea.Batch(cmd, root.scheduleWake())
+}
+
+func (m rootModel) update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
-		if m.currentView == MainView {
-			newModel, newCmd := m.mainView.Update(msg)
-			if newMainView, ok := newModel.(views.MainViewModel); ok {
-				m.mainView = newMainView
-			}
-			return m, newCmd
+
+		var cmds []tea.Cmd
+		newModel, newCmd := m.mainView.Update(msg)
+		if newMainView, ok := newModel.(views.MainViewModel); ok {
+			m.mainView = newMainView
 		}
+		cmds = append(cmds, newCmd)
 
-		newModel, newCmd := m.formView.Update(msg)
+		newModel, newCmd = m.boardView.Update(msg)
+		if newBoardView, ok := newModel.(views.BoardViewModel); ok {
+			m.boardView = newBoardView
+		}
+		cmds = append(cmds, newCmd)
+
+		newModel, newCmd = m.calendarView.Update(msg)
+		if newCalendarView, ok := newModel.(views.CalendarViewModel); ok {
+			m.calendarView = newCalendarView
+		}
+		cmds = append(cmds, newCmd)
+
+		newModel, newCmd = m.agendaView.Update(msg)
+		if newAgendaView, ok := newModel.(views.AgendaViewModel); ok {
+			m.agendaView = newAgendaView
+		}
+		cmds = append(cmds, newCmd)
+
+		newModel, newCmd = m.formView.Update(msg)
 		if newFormView, ok := newModel.(views.FormViewModel); ok {
 			m.formView = newFormView
 		}
-		return m, newCmd
+		cmds = append(cmds, newCmd)
+
+		newModel, newCmd = m.detailView.Update(msg)
+		if newDetailView, ok := newModel.(views.DetailViewModel); ok {
+			m.detailView = newDetailView
+		}
+		cmds = append(cmds, newCmd)
+
+		newModel, newCmd = m.trashView.Update(msg)
+		if newTrashView, ok := newModel.(views.TrashViewModel); ok {
+			m.trashView = newTrashView
+		}
+		cmds = append(cmds, newCmd)
+
+		newModel, newCmd = m.matrixView.Update(msg)
+		if newMatrixView, ok := newModel.(views.MatrixViewModel); ok {
+			m.matrixView = newMatrixView
+		}
+		cmds = append(cmds, newCmd)
+
+		newModel, newCmd = m.archiveView.Update(msg)
+		if newArchiveView, ok := newModel.(views.ArchiveViewModel); ok {
+			m.archiveView = newArchiveView
+		}
+		cmds = append(cmds, newCmd)
+
+		newModel, newCmd = m.timeLogView.Update(msg)
+		if newTimeLogView, ok := newModel.(views.TimeLogViewModel); ok {
+			m.timeLogView = newTimeLogView
+		}
+		cmds = append(cmds, newCmd)
+
+		newModel, newCmd = m.effortView.Update(msg)
+		if newEffortView, ok := newModel.(views.EffortViewModel); ok {
+			m.effortView = newEffortView
+		}
+		cmds = append(cmds, newCmd)
+
+		newModel, newCmd = m.focusView.Update(msg)
+		if newFocusView, ok := newModel.(views.FocusViewModel); ok {
+			m.focusView = newFocusView
+		}
+		cmds = append(cmds, newCmd)
+
+		newModel, newCmd = m.deferredView.Update(msg)
+		if newDeferredView, ok := newModel.(views.DeferredViewModel); ok {
+			m.deferredView = newDeferredView
+		}
+		cmds = append(cmds, newCmd)
+
+		newModel, _ = m.palette.Update(msg)
+		if newPalette, ok := newModel.(views.PaletteModel); ok {
+			m.palette = newPalette
+		}
+
+		newModel, _ = m.confirm.Update(msg)
+		if newConfirm, ok := newModel.(views.ConfirmModel); ok {
+			m.confirm = newConfirm
+		}
+
+		newModel, _ = m.help.Update(msg)
+		if newHelp, ok := newModel.(views.HelpModel); ok {
+			m.help = newHelp
+		}
+		return m, tea.Batch(cmds...)
+
+	case views.RunCommandMsg:
+		// Replay the command's key press in the view it was picked from
+		m.paletteOpen = false
+		return m.Update(msg.Command.KeyMsg())
 
 	case views.ShowDetailMsg:
-		m.detailView = views.NewDetailViewModel(msg.Task)
+		m.detailView = views.NewDetailViewModel(msg.Task, m.detailTasks())
+		newModel, _ := m.detailView.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
+		if newDetailView, ok := newModel.(views.DetailViewModel); ok {
+			m.detailView = newDetailView
+		}
 		m.currentView = DetailView
 		return m, nil
 
+	case timerTickMsg:
+		// Keep ticking only while a timer runs
+		if _, ok := runningTimer(m.tasks); !ok {
+			m.ticking = false
+			return m, nil
+		}
+		return m, timerTick()
+
+	case wakeMsg:
+		// Ticks for a wake time that has since changed are dropped
+		if !time.Time(msg).Equal(m.wakeAt) {
+			return m, nil
+		}
+		m.wakeAt = time.Time{}
+		m.refreshViews()
+		return m, nil
+
+	case views.ToggleTimerMsg:
+		cmd := m.toggleTimer(msg.TaskID)
+
+		// Update storage
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
+		return m, cmd
+
+	case views.SetTimeEntriesMsg:
+		for i := range m.tasks {
+			if m.tasks[i].ID == msg.TaskID {
+				m.tasks[i].TimeEntries = msg.Entries
+				break
+			}
+		}
+
+		// Update storage
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
+		return m, nil
+
+	case views.SetChecklistMsg:
+		for i := range m.tasks {
+			if m.tasks[i].ID == msg.TaskID {
+				before := m.tasks[i]
+				m.tasks[i].Checklist = msg.Items
+				m.record(i, before, time.Now())
+				break
+			}
+		}
+
+		// Update storage
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
+		return m, nil
+
+	case views.ShowTimeLogMsg:
+		m.timeLogView = views.NewTimeLogViewModel(msg.Task)
+		newModel, _ := m.timeLogView.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
+		if newTimeLogView, ok := newModel.(views.TimeLogViewModel); ok {
+			m.timeLogView = newTimeLogView
+		}
+		m.currentView = TimeLogView
+		return m, nil
+
+	case views.ShowFocusMsg:
+		m.focusView = views.NewFocusViewModel(msg.Task, m.pomodoro)
+		newModel, _ := m.focusView.Update(tea.WindowSizeMsg{Width: m.width, Height: m.height})
+		if newFocusView, ok := newModel.(views.FocusViewModel); ok {
+			m.focusView = newFocusView
+		}
+		m.focusReturn = m.currentView
+		m.currentView = FocusView
+		return m, m.focusView.Init()
+
+	case views.FocusTickMsg:
+		// The countdown keeps running under the palette, the help and dialogs,
+		// and stops once something else replaced the focus view
+		if m.currentView != FocusView {
+			m.focusView = views.FocusViewModel{}
+			return m, nil
+		}
+		newModel, cmd := m.focusView.Update(msg)
+		if newFocusView, ok := newModel.(views.FocusViewModel); ok {
+			m.focusView = newFocusView
+		}
+		return m, cmd
+
+	case views.RingBellMsg:
+		m.bell = true
+		return m, tea.Tick(bellFrame, func(time.Time) tea.Msg { return bellDoneMsg{} })
+
+	case bellDoneMsg:
+		m.bell = false
+		return m, nil
+
+	case views.PomodoroDoneMsg:
+		for i := range m.tasks {
+			if m.tasks[i].ID == msg.TaskID {
+				m.tasks[i].LogPomodoro(msg.Start, msg.End)
+				break
+			}
+		}
+
+		// Update storage
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
+		return m, nil
+
+	case views.CompleteTaskMsg:
+		for i := range m.tasks {
+			if m.tasks[i].ID == msg.TaskID {
+				if done, ok := m.workflow.Done(); ok && !m.tasks[i].Completed {
+					before, now := m.tasks[i], time.Now()
+					m.tasks[i].SetStatus(done, now)
+					m.record(i, before, now)
+				}
+				break
+			}
+		}
+
+		// Update storage
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
+		return m, nil
+
+	case views.AdvanceTaskMsg:
+		// Find the task and move it along its workflow
+		for i := range m.tasks {
+			if m.tasks[i].ID == msg.TaskID {
+				if next, ok := m.workflow.Next(m.tasks[i].Status); ok {
+					before, now := m.tasks[i], time.Now()
+					m.tasks[i].SetStatus(next, now)
+					m.record(i, before, now)
+				}
+				break
+			}
+		}
+
+		// Update storage
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
+		return m, nil
+
 	case views.ToggleTaskMsg:
-		// Find and toggle the task
 		for i := range m.tasks {
 			if m.tasks[i].ID == msg.TaskID {
-				m.tasks[i].Completed = !m.tasks[i].Completed
+				// Done tasks reopen in the initial state
+				status, ok := m.workflow.Status(m.workflow.Initial), true
+				if !m.tasks[i].Completed {
+					status, ok = m.workflow.Done()
+				}
+				if ok {
+					before, now := m.tasks[i], time.Now()
+					m.tasks[i].SetStatus(status, now)
+					m.record(i, before, now)
+				}
+				break
+			}
+		}
+
+		// Update storage
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
+		return m, nil
+
+	case views.SetStatusMsg:
+		for i := range m.tasks {
+			task := &m.tasks[i]
+			if task.ID == msg.TaskID && m.workflow.CanMove(task.Status, msg.Status) {
+				before, now := *task, time.Now()
+				task.SetStatus(m.workflow.Status(msg.Status), now)
+				m.record(i, before, now)
+				break
+			}
+		}
+
+		// Update storage
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
+		return m, nil
+
+	case views.RescheduleTaskMsg:
+		for i := range m.tasks {
+			if m.tasks[i].ID == msg.TaskID {
+				before := m.tasks[i]
+				m.tasks[i].DueDate = msg.DueDate
+				m.record(i, before, time.Now())
 				break
 			}
 		}
@@ -98,50 +948,285 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		// Update storage
 		m.store.Save(m.tasks)
 
-		// Update main view
-		m.mainView.UpdateTasks(m.tasks)
+		// Update task views
+		m.refreshViews()
+		return m, nil
+
+	case views.BulkActionMsg:
+		if msg.Action == views.BulkDelete {
+			return m, m.openConfirm(views.ConfirmMsg{
+				Title:   "Move to trash",
+				Prompt:  fmt.Sprintf("Move %d tasks to the trash?", len(msg.TaskIDs)),
+				Confirm: views.TrashTasksMsg{TaskIDs: msg.TaskIDs},
+			})
+		}
+
+		before := m.snapshot(msg.TaskIDs)
+		if msg.Action == views.BulkSnooze && !msg.Until.IsZero() {
+			// Hidden tasks are not being worked on
+			m.stopTimers(msg.TaskIDs)
+		}
+		m.applyBulk(msg)
+		m.pushUndo(before)
+
+		// One write for the whole batch
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
+		return m, nil
+
+	case views.UndoMsg:
+		if len(m.undo) == 0 {
+			return m, nil
+		}
+		m.popUndo()
+
+		// Update storage
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
 		return m, nil
 
 	case views.EditTaskMsg:
 		m.formView = views.NewFormViewModel()
 		m.formView.InitForEdit(msg.Task)
-		m.currentView = FormView
+		m.openForm()
 		return m, nil
 
 	case views.DeleteTaskMsg:
-		// Find and remove the task
-		for i, task := range m.tasks {
+		// Ask first: the delete icon sits right next to edit
+		for _, task := range m.tasks {
 			if task.ID == msg.TaskID {
-				// Remove task from slice
-				m.tasks = slices.Delete(m.tasks, i, i+1)
-				break
+				return m, m.openConfirm(views.ConfirmMsg{
+					Title:   "Move to trash",
+					Prompt:  fmt.Sprintf("Move %q to the trash?", task.Title),
+					Confirm: views.TrashTasksMsg{TaskIDs: []string{task.ID}},
+				})
 			}
 		}
+		return m, nil
+
+	case views.ConfirmMsg:
+		return m, m.openConfirm(msg)
+
+	case views.TrashTasksMsg:
+		// Trashing can be undone like a bulk action
+		before := m.snapshot(msg.TaskIDs)
+		m.stopTimers(msg.TaskIDs)
+		m.setTrashed(msg.TaskIDs, true)
+		m.pushUndo(before)
+
+		// Update storage
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
+		return m, nil
+
+	case views.RestoreTasksMsg:
+		m.setTrashed(msg.TaskIDs, false)
 
 		// Update storage
 		m.store.Save(m.tasks)
 
-		// Update main view
-		m.mainView.UpdateTasks(m.tasks)
+		// Update task views
+		m.refreshViews()
 		return m, nil
 
+	case views.PurgeTasksMsg:
+		m.tasks = slices.DeleteFunc(m.tasks, func(task models.Task) bool {
+			return task.Trashed() && slices.Contains(msg.TaskIDs, task.ID)
+		})
+		// Purging is final; undo must not reach back past it
+		m.undo = nil
+
+		// Update storage
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
+		return m, nil
+
+	case views.ArchiveTasksMsg:
+		m.stopTimers(msg.TaskIDs)
+		var archived []models.Task
+		kept := slices.DeleteFunc(slices.Clone(m.tasks), func(task models.Task) bool {
+			if slices.Contains(msg.TaskIDs, task.ID) {
+				archived = append(archived, task)
+				return true
+			}
+			return false
+		})
+		if len(archived) == 0 {
+			return m, nil
+		}
+		now := time.Now()
+		for i := range archived {
+			archived[i].Log(models.FieldArchived, "", "archived", m.user, now)
+		}
+		if err := m.archive.Append(archived); err != nil {
+			return m, func() tea.Msg { return err }
+		}
+		m.tasks = kept
+		// Archiving is not undoable either; unarchive brings tasks back
+		m.undo = nil
+
+		// Update storage
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
+		return m, nil
+
+	case views.UnarchiveTasksMsg:
+		restored, err := m.archive.Remove(msg.TaskIDs)
+		if err != nil {
+			return m, func() tea.Msg { return err }
+		}
+		now := time.Now()
+		for i := range restored {
+			restored[i].Log(models.FieldArchived, "archived", "", m.user, now)
+			m.workflow.Migrate(&restored[i])
+			restored[i].ImportChecklist()
+		}
+		m.tasks = append(m.tasks, restored...)
+
+		// Update storage
+		m.store.Save(m.tasks)
+
+		// Update task views
+		m.refreshViews()
+		return m, m.loadArchive()
+
 	case tea.KeyMsg:
-		if msg.String() == "ctrl+c" {
+		if key.Matches(msg, m.keys.Global.Quit) {
 			return m, tea.Quit
 		}
 
-		if m.currentView == MainView && msg.String() == "n" {
-			m.currentView = FormView
+		if m.paletteOpen {
+			newModel, cmd := m.palette.Update(msg)
+			if newPalette, ok := newModel.(views.PaletteModel); ok {
+				m.palette = newPalette
+				m.paletteOpen = !newPalette.Closed()
+			}
+			return m, cmd
+		}
+
+		if m.helpOpen {
+			newModel, cmd := m.help.Update(msg)
+			if newHelp, ok := newModel.(views.HelpModel); ok {
+				m.help = newHelp
+				m.helpOpen = !newHelp.Closed()
+			}
+			return m, cmd
+		}
+
+		if m.confirmOpen {
+			newModel, cmd := m.confirm.Update(msg)
+			if newConfirm, ok := newModel.(views.ConfirmModel); ok {
+				m.confirm = newConfirm
+				m.confirmOpen = !newConfirm.Closed()
+			}
+			return m, cmd
+		}
+
+		if key.Matches(msg, m.keys.Global.Palette) && m.currentView != ErrorView {
+			m.palette = views.NewPaletteModel(m.currentView.String(), m.width, m.height)
+			m.paletteOpen = true
+			return m, m.palette.Init()
+		}
+
+		if key.Matches(msg, m.keys.Global.Theme) {
+			views.SetTheme(views.NextTheme())
+			return m, nil
+		}
+
+		// Let an open prompt receive every key
+		if m.currentView == MainView && m.mainView.Capturing() {
+			break
+		}
+		if m.currentView == ArchiveView && m.archiveView.Capturing() {
+			break
+		}
+		if m.currentView == TimeLogView && m.timeLogView.Capturing() {
+			break
+		}
+		if m.currentView == DetailView && m.detailView.Capturing() {
+			break
+		}
+
+		// The form's text fields receive printable keys, so only non-text help keys work there
+		typing := m.currentView == FormView && msg.Type == tea.KeyRunes
+		if key.Matches(msg, m.keys.Global.Help) && m.currentView != ErrorView && !typing {
+			m.help = views.NewHelpModel(m.currentView.String(), m.width, m.height)
+			m.helpOpen = true
+			return m, m.help.Init()
+		}
+
+		if m.currentView == MainView && key.Matches(msg, m.keys.Main.Quit) {
+			return m, tea.Quit
+		}
+
+		if (m.currentView == MainView || m.currentView == BoardView || m.currentView == AgendaView || m.currentView == MatrixView) && key.Matches(msg, m.keys.Main.New) {
+			m.openForm()
+			return m, nil
+		}
+
+		if m.currentView == MainView && key.Matches(msg, m.keys.Main.Board) {
+			m.currentView = BoardView
+			m.listView = BoardView
+			return m, nil
+		}
+
+		if m.currentView == MainView && key.Matches(msg, m.keys.Main.Calendar) {
+			m.currentView = CalendarView
+			m.listView = CalendarView
+			return m, nil
+		}
+
+		if m.currentView == MainView && key.Matches(msg, m.keys.Main.Agenda) {
+			m.currentView = AgendaView
+			m.listView = AgendaView
 			return m, nil
 		}
 
-		if m.currentView == FormView && msg.String() == "esc" {
-			m.currentView = MainView
+		if m.currentView == MainView && key.Matches(msg, m.keys.Main.Matrix) {
+			m.currentView = MatrixView
+			m.listView = MatrixView
 			return m, nil
 		}
 
-		if m.currentView == DetailView && (msg.String() == "esc" || msg.String() == "q") {
-			m.currentView = MainView
+		if m.currentView == MainView && key.Matches(msg, m.keys.Main.Effort) {
+			m.currentView = EffortView
+			m.listView = EffortView
+			return m, nil
+		}
+
+		if m.currentView == MainView && key.Matches(msg, m.keys.Main.Deferred) {
+			m.currentView = DeferredView
+			m.listView = DeferredView
+			return m, nil
+		}
+
+		if m.currentView == MainView && key.Matches(msg, m.keys.Main.Trash) {
+			m.currentView = TrashView
+			return m, nil
+		}
+
+		if m.currentView == MainView && key.Matches(msg, m.keys.Main.Archive) {
+			m.currentView = ArchiveView
+			return m, m.loadArchive()
+		}
+
+		if m.currentView == FormView && key.Matches(msg, m.keys.Form.Cancel) {
+			m.currentView = m.formReturn
+			return m, nil
+		}
+
+		if m.currentView == DetailView && key.Matches(msg, m.keys.Detail.Back) {
+			m.currentView = m.listView
 			return m, nil
 		}
 	case error:
@@ -151,6 +1236,38 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		return m, m.errorView.Init()
 	}
 
+	// The open palette owns the cursor blink and other input messages
+	if m.paletteOpen {
+		newModel, cmd := m.palette.Update(msg)
+		if newPalette, ok := newModel.(views.PaletteModel); ok {
+			m.palette = newPalette
+		}
+		return m, cmd
+	}
+
+	// The open modal takes the mouse clicks
+	if m.confirmOpen {
+		if _, ok := msg.(tea.MouseMsg); ok {
+			newModel, cmd := m.confirm.Update(msg)
+			if newConfirm, ok := newModel.(views.ConfirmModel); ok {
+				m.confirm = newConfirm
+				m.confirmOpen = !newConfirm.Closed()
+			}
+			return m, cmd
+		}
+	}
+
+	// The open help scrolls with the mouse wheel
+	if m.helpOpen {
+		if _, ok := msg.(tea.MouseMsg); ok {
+			newModel, cmd := m.help.Update(msg)
+			if newHelp, ok := newModel.(views.HelpModel); ok {
+				m.help = newHelp
+			}
+			return m, cmd
+		}
+	}
+
 	switch m.currentView {
 	case MainView:
 		newModel, newCmd := m.mainView.Update(msg)
@@ -165,12 +1282,15 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			m.formView = newFormView
 			if newFormView.Done() {
 				newTask := newFormView.GetTask()
+				// "- [ ]" lines typed into the description become checklist items
+				newTask.ImportChecklist()
 
 				if m.formView.IsEditing() {
 					// Update existing task
 					for i, task := range m.tasks {
 						if task.ID == newTask.ID {
 							m.tasks[i] = newTask
+							m.record(i, task, time.Now())
 							break
 						}
 					}
@@ -179,10 +1299,10 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 					m.tasks = append(m.tasks, newTask)
 				}
 
-				// Update storage and view
+				// Update storage and views
 				m.store.Save(m.tasks)
-				m.mainView.UpdateTasks(m.tasks)
-				m.currentView = MainView
+				m.refreshViews()
+				m.currentView = m.formReturn
 				m.formView = views.NewFormViewModel()
 			}
 		}
@@ -193,7 +1313,7 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if newDetailView, ok := newModel.(views.DetailViewModel); ok {
 			m.detailView = newDetailView
 			if m.detailView.ShouldReturn() {
-				m.currentView = MainView
+				m.currentView = m.listView
 			}
 		}
 		return m, cmd
@@ -203,16 +1323,158 @@ func (m rootModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		if newErrorView, ok := newModel.(views.ErrorViewModel); ok {
 			m.errorView = newErrorView
 			if m.errorView.ShouldClose() {
+				m.currentView = m.listView
+			}
+		}
+		return m, cmd
+
+	case BoardView:
+		newModel, cmd := m.boardView.Update(msg)
+		if newBoardView, ok := newModel.(views.BoardViewModel); ok {
+			m.boardView = newBoardView
+			if m.boardView.ShouldReturn() {
+				m.boardView.ResetReturn()
+				m.currentView = MainView
+				m.listView = MainView
+			}
+		}
+		return m, cmd
+
+	case AgendaView:
+		newModel, cmd := m.agendaView.Update(msg)
+		if newAgendaView, ok := newModel.(views.AgendaViewModel); ok {
+			m.agendaView = newAgendaView
+			if m.agendaView.ShouldReturn() {
+				m.agendaView.ResetReturn()
+				m.currentView = MainView
+				m.listView = MainView
+			}
+		}
+		return m, cmd
+
+	case TrashView:
+		newModel, cmd := m.trashView.Update(msg)
+		if newTrashView, ok := newModel.(views.TrashViewModel); ok {
+			m.trashView = newTrashView
+			if m.trashView.ShouldReturn() {
+				m.trashView.ResetReturn()
+				m.currentView = MainView
+			}
+		}
+		return m, cmd
+
+	case MatrixView:
+		newModel, cmd := m.matrixView.Update(msg)
+		if newMatrixView, ok := newModel.(views.MatrixViewModel); ok {
+			m.matrixView = newMatrixView
+			if m.matrixView.ShouldReturn() {
+				m.matrixView.ResetReturn()
+				m.currentView = MainView
+				m.listView = MainView
+			}
+		}
+		return m, cmd
+
+	case EffortView:
+		newModel, cmd := m.effortView.Update(msg)
+		if newEffortView, ok := newModel.(views.EffortViewModel); ok {
+			m.effortView = newEffortView
+			if m.effortView.ShouldReturn() {
+				m.effortView.ResetReturn()
+				m.currentView = MainView
+				m.listView = MainView
+			}
+		}
+		return m, cmd
+
+	case DeferredView:
+		newModel, cmd := m.deferredView.Update(msg)
+		if newDeferredView, ok := newModel.(views.DeferredViewModel); ok {
+			m.deferredView = newDeferredView
+			if m.deferredView.ShouldReturn() {
+				m.deferredView.ResetReturn()
+				m.currentView = MainView
+				m.listView = MainView
+			}
+		}
+		return m, cmd
+
+	case FocusView:
+		newModel, cmd := m.focusView.Update(msg)
+		if newFocusView, ok := newModel.(views.FocusViewModel); ok {
+			m.focusView = newFocusView
+			if m.focusView.ShouldReturn() {
+				// An empty view lets the countdown's ticks die out
+				m.focusView = views.FocusViewModel{}
+				m.currentView = m.focusReturn
+			}
+		}
+		return m, cmd
+
+	case TimeLogView:
+		newModel, cmd := m.timeLogView.Update(msg)
+		if newTimeLogView, ok := newModel.(views.TimeLogViewModel); ok {
+			m.timeLogView = newTimeLogView
+			if m.timeLogView.ShouldReturn() {
+				m.timeLogView.ResetReturn()
+				m.currentView = DetailView
+			}
+		}
+		return m, cmd
+
+	case ArchiveView:
+		newModel, cmd := m.archiveView.Update(msg)
+		if newArchiveView, ok := newModel.(views.ArchiveViewModel); ok {
+			m.archiveView = newArchiveView
+			if m.archiveView.ShouldReturn() {
+				m.archiveView.ResetReturn()
+				// Free the archived tasks again
+				m.archiveView.UpdateTasks(nil)
 				m.currentView = MainView
 			}
 		}
 		return m, cmd
+
+	case CalendarView:
+		newModel, cmd := m.calendarView.Update(msg)
+		if newCalendarView, ok := newModel.(views.CalendarViewModel); ok {
+			m.calendarView = newCalendarView
+			if m.calendarView.ShouldReturn() {
+				m.calendarView.ResetReturn()
+				m.currentView = MainView
+				m.listView = MainView
+			}
+		}
+		return m, cmd
 	}
 
 	return m, nil
 }
 
 func (m rootModel) View() string {
+	// Record where clickable elements landed, for the next mouse event
+	view := zone.Scan(m.render())
+	if m.bell {
+		// The bell goes out with the frame rather than behind the renderer
+		view = "\a" + view
+	}
+	return view
+}
+
+// render draws the palette, the help or the current view
+func (m rootModel) render() string {
+	if m.paletteOpen {
+		return m.palette.View()
+	}
+
+	if m.helpOpen {
+		return m.help.View()
+	}
+
+	if m.confirmOpen {
+		return m.confirm.View()
+	}
+
 	switch m.currentView {
 	case MainView:
 		return m.mainView.View()
@@ -222,6 +1484,26 @@ func (m rootModel) View() string {
 		return m.detailView.View()
 	case ErrorView:
 		return m.errorView.View()
+	case BoardView:
+		return m.boardView.View()
+	case CalendarView:
+		return m.calendarView.View()
+	case AgendaView:
+		return m.agendaView.View()
+	case TrashView:
+		return m.trashView.View()
+	case ArchiveView:
+		return m.archiveView.View()
+	case MatrixView:
+		return m.matrixView.View()
+	case TimeLogView:
+		return m.timeLogView.View()
+	case EffortView:
+		return m.effortView.View()
+	case FocusView:
+		return m.focusView.View()
+	case DeferredView:
+		return m.deferredView.View()
 	default:
 		return "Unknown View"
 	}
@@ -0,0 +1,492 @@
+package tui
+
+import (
+	"os"
+	"slices"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sabry-awad97/task-manager/internal/config"
+	"github.com/sabry-awad97/task-manager/internal/storage"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+	"github.com/sabry-awad97/task-manager/internal/tui/views"
+)
+
+// newTestRoot builds the app on tasks, saved in a temporary directory
+func newTestRoot(t *testing.T, tasks ...models.Task) rootModel {
+	t.Helper()
+	return newTestRootWith(t, config.Default(), tasks...)
+}
+
+// newTestRootWith builds the app with cfg on tasks, saved in a temporary directory
+func newTestRootWith(t *testing.T, cfg config.Config, tasks ...models.Task) rootModel {
+	t.Helper()
+	dir, err := os.Getwd()
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err := os.Chdir(t.TempDir()); err != nil {
+		t.Fatal(err)
+	}
+	t.Cleanup(func() { os.Chdir(dir) })
+
+	if err := storage.NewJSONStore("tasks.json").Save(tasks); err != nil {
+		t.Fatal(err)
+	}
+	m, err := NewRootModel(cfg)
+	if err != nil {
+		t.Fatal(err)
+	}
+	return update(m, tea.WindowSizeMsg{Width: 120, Height: 40})
+}
+
+// update sends msg to m and ignores the commands it returns
+func update(m rootModel, msg tea.Msg) rootModel {
+	next, _ := m.Update(msg)
+	return next.(rootModel)
+}
+
+// taskByTitle finds a task of m by its title
+func taskByTitle(t *testing.T, m rootModel, title string) models.Task {
+	t.Helper()
+	for _, task := range m.tasks {
+		if task.Title == title {
+			return task
+		}
+	}
+	t.Fatalf("no task %q", title)
+	return models.Task{}
+}
+
+func TestUndoAfterLaterEdit(t *testing.T) {
+	due := time.Date(2026, 10, 20, 0, 0, 0, 0, time.UTC)
+	a := models.NewTask("a", "", due, models.Low)
+	a.Status = "todo"
+	b := models.NewTask("b", "", due, models.Low)
+	b.Status = "todo"
+	m := newTestRoot(t, a, b)
+
+	m = update(m, views.BulkActionMsg{Action: views.BulkSetPriority, TaskIDs: []string{a.ID}, Priority: models.High})
+
+	// Add task c through the form
+	m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("n")})
+	m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("c")})
+	for i := 0; i < 10 && m.currentView == FormView; i++ {
+		m = update(m, tea.KeyMsg{Type: tea.KeyEnter})
+	}
+	m = update(m, views.AdvanceTaskMsg{TaskID: b.ID})
+	advanced := taskByTitle(t, m, "b").Status
+
+	m = update(m, views.UndoMsg{})
+
+	if got := taskByTitle(t, m, "a").Priority; got != models.Low {
+		t.Errorf("a priority = %v, want %v", got, models.Low)
+	}
+	taskByTitle(t, m, "c")
+	if got := taskByTitle(t, m, "b").Status; got != advanced {
+		t.Errorf("b status = %q, want %q", got, advanced)
+	}
+}
+
+func TestUndoKeepsLaterChangeToSameField(t *testing.T) {
+	a := models.NewTask("a", "", time.Now(), models.Low)
+	m := newTestRoot(t, a)
+
+	m = update(m, views.BulkActionMsg{Action: views.BulkSetPriority, TaskIDs: []string{a.ID}, Priority: models.High})
+	m = update(m, views.BulkActionMsg{Action: views.BulkAddTag, TaskIDs: []string{a.ID}, Tag: "home"})
+	m = update(m, views.BulkActionMsg{Action: views.BulkSetPriority, TaskIDs: []string{a.ID}, Priority: models.Medium})
+
+	// Undo the last priority change, then the tag
+	m = update(m, views.UndoMsg{})
+	m = update(m, views.UndoMsg{})
+	task := taskByTitle(t, m, "a")
+	if task.Priority != models.High || len(task.Tags) != 0 {
+		t.Fatalf("after two undos: priority %v, tags %v", task.Priority, task.Tags)
+	}
+	m = update(m, views.UndoMsg{})
+	if got := taskByTitle(t, m, "a").Priority; got != models.Low {
+		t.Fatalf("priority = %v, want %v", got, models.Low)
+	}
+}
+
+func TestUndoAfterPurge(t *testing.T) {
+	a := models.NewTask("a", "", time.Now(), models.Low)
+	m := newTestRoot(t, a)
+
+	m = update(m, views.TrashTasksMsg{TaskIDs: []string{a.ID}})
+	m = update(m, views.PurgeTasksMsg{TaskIDs: []string{a.ID}})
+	m = update(m, views.UndoMsg{})
+
+	if len(m.tasks) != 0 || len(m.active) != 0 {
+		t.Fatalf("undo after purge left %d tasks", len(m.tasks))
+	}
+}
+
+// lastChange is the newest entry in the activity log of the task titled title
+func lastChange(t *testing.T, m rootModel, title string) models.Change {
+	t.Helper()
+	history := taskByTitle(t, m, title).History
+	if len(history) == 0 {
+		t.Fatalf("%s has no history", title)
+	}
+	return history[len(history)-1]
+}
+
+func TestTrashAndArchiveAreRecorded(t *testing.T) {
+	a := models.NewTask("a", "", time.Time{}, models.Low)
+	a.Status = "todo"
+	m := newTestRoot(t, a)
+
+	m = update(m, views.TrashTasksMsg{TaskIDs: []string{a.ID}})
+	if got := lastChange(t, m, "a"); got.Field != models.FieldDeletedAt || got.To == "" {
+		t.Errorf("after trash, last change = %+v", got)
+	}
+	m = update(m, views.RestoreTasksMsg{TaskIDs: []string{a.ID}})
+	if got := lastChange(t, m, "a"); got.Field != models.FieldDeletedAt || got.To != "" {
+		t.Errorf("after restore, last change = %+v", got)
+	}
+
+	m = update(m, views.ArchiveTasksMsg{TaskIDs: []string{a.ID}})
+	m = update(m, views.UnarchiveTasksMsg{TaskIDs: []string{a.ID}})
+	history := taskByTitle(t, m, "a").History
+	if len(history) < 2 {
+		t.Fatalf("history = %+v", history)
+	}
+	archived, unarchived := history[len(history)-2], history[len(history)-1]
+	if archived.Field != models.FieldArchived || archived.To == "" {
+		t.Errorf("archive change = %+v", archived)
+	}
+	if unarchived.Field != models.FieldArchived || unarchived.To != "" {
+		t.Errorf("unarchive change = %+v", unarchived)
+	}
+}
+
+func TestRestoreSchedulesWake(t *testing.T) {
+	until := time.Now().Add(time.Hour).Truncate(time.Second)
+	a := models.NewTask("a", "", time.Time{}, models.Low)
+	a.Status = "todo"
+	a.DeferUntil = &until
+	trashed := time.Now()
+	a.DeletedAt = &trashed
+	m := newTestRoot(t, a)
+	if !m.wakeAt.IsZero() {
+		t.Fatalf("wake scheduled for a trashed task at %v", m.wakeAt)
+	}
+
+	next, cmd := m.Update(views.RestoreTasksMsg{TaskIDs: []string{a.ID}})
+	m = next.(rootModel)
+	if !m.wakeAt.Equal(until) || cmd == nil {
+		t.Errorf("after restore, wake at %v with cmd %v, want %v", m.wakeAt, cmd != nil, until)
+	}
+}
+
+func TestBellRingsWithTheFrame(t *testing.T) {
+	m := newTestRoot(t)
+
+	m = update(m, views.RingBellMsg{})
+	if !strings.HasPrefix(m.View(), "\a") {
+		t.Error("the frame after a bell does not ring it")
+	}
+	m = update(m, bellDoneMsg{})
+	if strings.Contains(m.View(), "\a") {
+		t.Error("the bell is still in the frame")
+	}
+}
+
+func TestDetailFromEffortReportShowsDeferredTask(t *testing.T) {
+	until := time.Now().Add(time.Hour)
+	a := models.NewTask("a", "", time.Time{}, models.Low)
+	a.Status = "todo"
+	d := models.NewTask("d", "", time.Time{}, models.Low)
+	d.Status = "todo"
+	d.Estimate = time.Hour
+	d.DeferUntil = &until
+	m := newTestRoot(t, a, d)
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("R")})
+	if m.currentView != EffortView {
+		t.Fatalf("view = %v, want the effort report", m.currentView)
+	}
+	next, cmd := m.Update(tea.KeyMsg{Type: tea.KeyEnter})
+	m = update(next.(rootModel), cmd())
+
+	if m.currentView != DetailView {
+		t.Fatalf("view = %v, want details", m.currentView)
+	}
+	if task, ok := m.detailView.Task(); !ok || task.ID != d.ID {
+		t.Errorf("details show %q, want %q", task.Title, d.Title)
+	}
+}
+
+func TestBulkCompleteAndAdvance(t *testing.T) {
+	var tasks []models.Task
+	for _, status := range []string{"todo", "review", "done", "cancelled"} {
+		task := models.NewTask(status, "", time.Time{}, models.Low)
+		task.Status = status
+		task.Completed = status == "done" || status == "cancelled"
+		tasks = append(tasks, task)
+	}
+	ids := func() []string {
+		var ids []string
+		for _, task := range tasks {
+			ids = append(ids, task.ID)
+		}
+		return ids
+	}()
+	m := newTestRoot(t, tasks...)
+
+	m = update(m, views.BulkActionMsg{Action: views.BulkComplete, TaskIDs: ids})
+	for title, want := range map[string]string{"todo": "done", "review": "done", "done": "done", "cancelled": "cancelled"} {
+		if task := taskByTitle(t, m, title); task.Status != want || !task.Completed {
+			t.Errorf("after complete, %s is %q (completed %v), want %q", title, task.Status, task.Completed, want)
+		}
+	}
+
+	m = update(m, views.UndoMsg{})
+	m = update(m, views.BulkActionMsg{Action: views.BulkAdvance, TaskIDs: ids})
+	for title, want := range map[string]string{"todo": "in_progress", "review": "done", "done": "todo", "cancelled": "todo"} {
+		if got := taskByTitle(t, m, title).Status; got != want {
+			t.Errorf("after advance, %s is %q, want %q", title, got, want)
+		}
+	}
+}
+
+func TestToggleCompletesAndReopens(t *testing.T) {
+	a := models.NewTask("a", "", time.Time{}, models.Low)
+	a.Status = "in_progress"
+	m := newTestRoot(t, a)
+
+	m = update(m, views.ToggleTaskMsg{TaskID: a.ID})
+	if task := taskByTitle(t, m, "a"); task.Status != "done" || !task.Completed || task.CompletedAt == nil {
+		t.Errorf("after toggle, a is %q (completed %v)", task.Status, task.Completed)
+	}
+	m = update(m, views.ToggleTaskMsg{TaskID: a.ID})
+	if task := taskByTitle(t, m, "a"); task.Status != "todo" || task.Completed {
+		t.Errorf("after second toggle, a is %q (completed %v)", task.Status, task.Completed)
+	}
+}
+
+func TestArchiveAsksBeforeUnfinishedTasks(t *testing.T) {
+	for _, done := range []bool{true, false} {
+		a := models.NewTask("a", "", time.Time{}, models.Low)
+		a.Status = "todo"
+		if done {
+			a.SetStatus(models.Status{ID: "done", Done: true}, time.Now())
+		}
+		m := newTestRoot(t, a)
+
+		next, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("A")})
+		m = next.(rootModel)
+		msg := cmd()
+		if confirm, ok := msg.(views.ConfirmMsg); ok {
+			if done {
+				t.Fatalf("archiving a done task asks %q", confirm.Prompt)
+			}
+			msg = confirm.Confirm
+		} else if !done {
+			t.Fatalf("archiving an unfinished task sent %T, want a confirmation", msg)
+		}
+		if _, ok := msg.(views.ArchiveTasksMsg); !ok {
+			t.Fatalf("archive sent %T", msg)
+		}
+
+		m = update(m, msg)
+		if len(m.tasks) != 0 {
+			t.Fatalf("%d tasks left after archiving", len(m.tasks))
+		}
+		archived, err := storage.NewJSONStore("archive.json").Load()
+		if err != nil || len(archived) != 1 || archived[0].ID != a.ID {
+			t.Fatalf("archive holds %v, %v", archived, err)
+		}
+
+		m = update(m, views.UnarchiveTasksMsg{TaskIDs: []string{a.ID}})
+		if task := taskByTitle(t, m, "a"); task.Completed != done {
+			t.Errorf("unarchived task completed = %v, want %v", task.Completed, done)
+		}
+		if archived, _ := storage.NewJSONStore("archive.json").Load(); len(archived) != 0 {
+			t.Errorf("archive still holds %v", archived)
+		}
+	}
+}
+
+func TestBulkEditsUndoAsOneStep(t *testing.T) {
+	due := time.Date(2026, 10, 20, 14, 30, 0, 0, time.UTC)
+	a := models.NewTask("a", "", due, models.Low)
+	a.Status = "todo"
+	b := models.NewTask("b", "", time.Time{}, models.Medium)
+	b.Status = "todo"
+	b.Tags = []string{"home"}
+	m := newTestRoot(t, a, b)
+	ids := []string{a.ID, b.ID}
+
+	m = update(m, views.BulkActionMsg{Action: views.BulkSetPriority, TaskIDs: ids, Priority: models.High})
+	m = update(m, views.BulkActionMsg{Action: views.BulkAddTag, TaskIDs: ids, Tag: "home"})
+	m = update(m, views.BulkActionMsg{Action: views.BulkReschedule, TaskIDs: ids,
+		DueDate: time.Date(2026, 11, 2, 0, 0, 0, 0, time.UTC)})
+
+	for _, title := range []string{"a", "b"} {
+		task := taskByTitle(t, m, title)
+		if task.Priority != models.High || !slices.Equal(task.Tags, []string{"home"}) {
+			t.Errorf("%s: priority %v, tags %v", title, task.Priority, task.Tags)
+		}
+	}
+	// Rescheduling keeps the time of day the task was due at
+	if got, want := taskByTitle(t, m, "a").DueDate, time.Date(2026, 11, 2, 14, 30, 0, 0, time.UTC); !got.Equal(want) {
+		t.Errorf("a is due %v, want %v", got, want)
+	}
+	saved, err := storage.NewJSONStore("tasks.json").Load()
+	if err != nil || len(saved) != 2 || saved[0].Priority != models.High {
+		t.Errorf("saved %v, %v", saved, err)
+	}
+
+	// Each bulk action undoes on its own, newest first
+	m = update(m, views.UndoMsg{})
+	if got := taskByTitle(t, m, "a").DueDate; !got.Equal(due) {
+		t.Errorf("after undoing the reschedule, a is due %v, want %v", got, due)
+	}
+	m = update(m, views.UndoMsg{})
+	if got := taskByTitle(t, m, "a").Tags; len(got) != 0 {
+		t.Errorf("after undoing the tag, a is tagged %v", got)
+	}
+	if got := taskByTitle(t, m, "b").Tags; !slices.Equal(got, []string{"home"}) {
+		t.Errorf("undoing the tag took b's own tag: %v", got)
+	}
+	m = update(m, views.UndoMsg{})
+	if a, b := taskByTitle(t, m, "a"), taskByTitle(t, m, "b"); a.Priority != models.Low || b.Priority != models.Medium {
+		t.Errorf("after undoing the priority, a is %v and b is %v", a.Priority, b.Priority)
+	}
+}
+
+func TestStartupPurgesOldTrash(t *testing.T) {
+	now := time.Now()
+	trashed := func(title string, age time.Duration) models.Task {
+		task := models.NewTask(title, "", time.Time{}, models.Low)
+		task.Status = "todo"
+		deleted := now.Add(-age)
+		task.DeletedAt = &deleted
+		return task
+	}
+	old := trashed("old", 31*24*time.Hour)
+	recent := trashed("recent", 24*time.Hour)
+	live := models.NewTask("live", "", time.Time{}, models.Low)
+	live.Status = "todo"
+
+	// The default config keeps deleted tasks for 30 days
+	m := newTestRoot(t, old, recent, live)
+	if len(m.tasks) != 2 {
+		t.Fatalf("%d tasks after startup, want 2", len(m.tasks))
+	}
+	taskByTitle(t, m, "recent")
+	taskByTitle(t, m, "live")
+	saved, err := storage.NewJSONStore("tasks.json").Load()
+	if err != nil || len(saved) != 2 {
+		t.Errorf("saved %d tasks, %v; want the purge written back", len(saved), err)
+	}
+}
+
+func TestEmptyTrashPurgesEveryTrashedTask(t *testing.T) {
+	var tasks []models.Task
+	for _, title := range []string{"a", "b", "c"} {
+		task := models.NewTask(title, "", time.Time{}, models.Low)
+		task.Status = "todo"
+		tasks = append(tasks, task)
+	}
+	m := newTestRoot(t, tasks...)
+	m = update(m, views.TrashTasksMsg{TaskIDs: []string{tasks[0].ID, tasks[1].ID}})
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("T")})
+	if m.currentView != TrashView {
+		t.Fatalf("view = %v, want the trash", m.currentView)
+	}
+	next, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("D")})
+	m = next.(rootModel)
+	confirm, ok := cmd().(views.ConfirmMsg)
+	if !ok {
+		t.Fatal("emptying the trash does not ask first")
+	}
+	purge, ok := confirm.Confirm.(views.PurgeTasksMsg)
+	if !ok || len(purge.TaskIDs) != 2 {
+		t.Fatalf("confirming sends %+v", confirm.Confirm)
+	}
+
+	m = update(m, purge)
+	if len(m.tasks) != 1 || m.tasks[0].Title != "c" {
+		t.Errorf("tasks left after emptying the trash: %v", m.tasks)
+	}
+	if saved, _ := storage.NewJSONStore("tasks.json").Load(); len(saved) != 1 {
+		t.Errorf("saved %d tasks, want 1", len(saved))
+	}
+}
+
+func TestStartupArchivesOldCompletedTasks(t *testing.T) {
+	now := time.Now()
+	done := func(title string, age time.Duration) models.Task {
+		task := models.NewTask(title, "", time.Time{}, models.Low)
+		task.SetStatus(models.Status{ID: "done", Done: true}, now.Add(-age))
+		return task
+	}
+	old := done("old", 10*24*time.Hour)
+	recent := done("recent", time.Hour)
+	trashed := done("trashed", 10*24*time.Hour)
+	deleted := now
+	trashed.DeletedAt = &deleted
+	open := models.NewTask("open", "", time.Time{}, models.Low)
+	open.Status = "todo"
+
+	cfg := config.Default()
+	cfg.ArchiveDays = 7
+	m := newTestRootWith(t, cfg, old, recent, trashed, open)
+
+	for _, task := range m.tasks {
+		if task.ID == old.ID {
+			t.Fatal("a task done 10 days ago is still in the task list")
+		}
+	}
+	if len(m.tasks) != 3 {
+		t.Errorf("%d tasks left, want 3", len(m.tasks))
+	}
+	archived, err := storage.NewJSONStore("archive.json").Load()
+	if err != nil || len(archived) != 1 || archived[0].ID != old.ID {
+		t.Fatalf("archive holds %v, %v", archived, err)
+	}
+
+	m = update(m, tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("Z")})
+	if task, ok := m.archiveView.SelectedTask(); m.currentView != ArchiveView || !ok || task.ID != old.ID {
+		t.Fatalf("archive view shows %q", task.Title)
+	}
+	next, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("u")})
+	m = update(next.(rootModel), cmd())
+	if task := taskByTitle(t, m, "old"); !task.Completed {
+		t.Error("unarchived task lost its completion")
+	}
+	if archived, _ := storage.NewJSONStore("archive.json").Load(); len(archived) != 0 {
+		t.Errorf("archive still holds %v", archived)
+	}
+}
+
+func TestStartupMigratesTasksWithoutAState(t *testing.T) {
+	pending := models.NewTask("pending", "", time.Time{}, models.Low)
+	completed := models.NewTask("completed", "", time.Time{}, models.Low)
+	completed.Completed = true
+	pending.Status, completed.Status = "", ""
+
+	m := newTestRoot(t, pending, completed)
+
+	want := map[string]string{"pending": "todo", "completed": "done"}
+	for title, status := range want {
+		if got := taskByTitle(t, m, title).Status; got != status {
+			t.Errorf("%s is %q, want %q", title, got, status)
+		}
+	}
+	saved, err := storage.NewJSONStore("tasks.json").Load()
+	if err != nil {
+		t.Fatal(err)
+	}
+	for _, task := range saved {
+		if task.Status != want[task.Title] {
+			t.Errorf("%s saved as %q, want %q", task.Title, task.Status, want[task.Title])
+		}
+	}
+}
@@ -0,0 +1,329 @@
+package views
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+var (
+	agendaSectionStyle        lipgloss.Style
+	agendaOverdueSectionStyle lipgloss.Style
+	agendaEmptyStyle          lipgloss.Style
+	agendaItemStyle           lipgloss.Style
+	agendaSelectedItemStyle   lipgloss.Style
+	agendaDueStyle            lipgloss.Style
+	agendaSummaryStyle        lipgloss.Style
+	agendaCapacityStyle       lipgloss.Style
+	agendaOverCapacityStyle   lipgloss.Style
+)
+
+// setAgendaStyles derives the agenda's styles from t
+func setAgendaStyles(t Theme) {
+	agendaSectionStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Secondary).
+		MarginTop(1)
+
+	agendaOverdueSectionStyle = agendaSectionStyle.
+		Foreground(t.Danger)
+
+	agendaEmptyStyle = lipgloss.NewStyle().
+		Foreground(t.Muted).
+		Italic(true).
+		PaddingLeft(2)
+
+	agendaItemStyle = lipgloss.NewStyle().
+		PaddingLeft(2)
+
+	agendaSelectedItemStyle = agendaItemStyle.
+		Background(t.Accent).
+		Foreground(t.OnAccent)
+
+	agendaDueStyle = lipgloss.NewStyle().
+		Foreground(t.Muted)
+
+	agendaSummaryStyle = lipgloss.NewStyle().
+		Foreground(t.Success).
+		Bold(true)
+
+	agendaCapacityStyle = lipgloss.NewStyle().
+		Foreground(t.Muted)
+
+	agendaOverCapacityStyle = lipgloss.NewStyle().
+		Foreground(t.Warning).
+		Bold(true)
+}
+
+type agendaKeyMap struct {
+	Up    key.Binding
+	Down  key.Binding
+	Enter key.Binding
+	Space key.Binding
+	Table key.Binding
+}
+
+func (k agendaKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Enter, k.Space, k.Table}
+}
+
+func (k agendaKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Enter},
+		{k.Space, keys.Main.New},
+		{k.Table},
+	}
+}
+
+// agendaSection is one titled group of tasks on the agenda
+type agendaSection struct {
+	title   string
+	overdue bool
+	tasks   []models.Task
+}
+
+type AgendaViewModel struct {
+	sections       []agendaSection
+	completedToday int // Tasks finished today, whenever they were due
+	dueToday       int
+	dueDoneToday   int           // Tasks due today that are done
+	planned        time.Duration // Estimated effort of the tasks due today
+	remaining      time.Duration // Estimated effort of the open tasks due today
+	cursor         int
+	width          int
+	height         int
+	shouldReturn   bool
+}
+
+func NewAgendaViewModel() AgendaViewModel {
+	return AgendaViewModel{}
+}
+
+func (m AgendaViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m AgendaViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Agenda.Table):
+			m.shouldReturn = true
+		case key.Matches(msg, keys.Agenda.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+		case key.Matches(msg, keys.Agenda.Down):
+			if m.cursor < m.itemCount()-1 {
+				m.cursor++
+			}
+		case key.Matches(msg, keys.Agenda.Enter):
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return ShowDetailMsg{Task: task}
+				}
+			}
+		case key.Matches(msg, keys.Agenda.Space):
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return AdvanceTaskMsg{TaskID: task.ID}
+				}
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m *AgendaViewModel) UpdateTasks(tasks []models.Task) {
+	now := time.Now()
+	today := truncateDay(now)
+	tomorrow := today.AddDate(0, 0, 1)
+	weekEnd := today.AddDate(0, 0, 8)
+
+	overdue := agendaSection{title: "Overdue", overdue: true}
+	dueToday := agendaSection{title: "Today"}
+	upcoming := agendaSection{title: "Next 7 days"}
+	m.completedToday = 0
+	m.dueToday = 0
+	m.dueDoneToday = 0
+	m.planned = 0
+	m.remaining = 0
+
+	for _, task := range tasks {
+		due := dueDay(task.DueDate)
+		if due.Equal(today) {
+			m.dueToday++
+			m.planned += task.Estimate
+			if task.Completed {
+				m.dueDoneToday++
+			} else {
+				m.remaining += task.Estimate
+			}
+		}
+		if task.Completed && task.CompletedAt != nil && sameDay(task.CompletedAt.Local(), today) {
+			m.completedToday++
+		}
+		if task.Completed || task.DueDate.IsZero() {
+			// Undated tasks have no day to be listed under
+			continue
+		}
+
+		switch {
+		case due.Before(today):
+			overdue.tasks = append(overdue.tasks, task)
+		case due.Before(tomorrow):
+			dueToday.tasks = append(dueToday.tasks, task)
+		case due.Before(weekEnd):
+			upcoming.tasks = append(upcoming.tasks, task)
+		}
+	}
+
+	m.sections = []agendaSection{overdue, dueToday, upcoming}
+	for _, section := range m.sections {
+		sortByPriority(section.tasks)
+	}
+
+	if m.cursor >= m.itemCount() {
+		m.cursor = max(m.itemCount()-1, 0)
+	}
+}
+
+// sortByPriority orders tasks by descending priority, then by due date
+func sortByPriority(tasks []models.Task) {
+	sort.SliceStable(tasks, func(i, j int) bool {
+		if tasks[i].Priority != tasks[j].Priority {
+			return tasks[i].Priority > tasks[j].Priority
+		}
+		return tasks[i].DueDate.Before(tasks[j].DueDate)
+	})
+}
+
+func (m AgendaViewModel) itemCount() int {
+	n := 0
+	for _, section := range m.sections {
+		n += len(section.tasks)
+	}
+	return n
+}
+
+func (m AgendaViewModel) SelectedTask() (models.Task, bool) {
+	idx := m.cursor
+	for _, section := range m.sections {
+		if idx < len(section.tasks) {
+			return section.tasks[idx], true
+		}
+		idx -= len(section.tasks)
+	}
+	return models.Task{}, false
+}
+
+func (m AgendaViewModel) ShouldReturn() bool {
+	return m.shouldReturn
+}
+
+// renderCapacity sums the estimates of the tasks due today against a workday
+func (m AgendaViewModel) renderCapacity() string {
+	workday := models.Workday()
+	capacity := fmt.Sprintf("Capacity for today: %s estimated of %gh", formatDuration(m.planned), workday.Hours())
+	if m.remaining != m.planned {
+		capacity += fmt.Sprintf(", %s still open", formatDuration(m.remaining))
+	}
+	if m.planned > workday {
+		return agendaOverCapacityStyle.Render(capacity + " (over capacity)")
+	}
+	return agendaCapacityStyle.Render(capacity)
+}
+
+// ResetReturn clears the return request so the agenda can be shown again
+func (m *AgendaViewModel) ResetReturn() {
+	m.shouldReturn = false
+}
+
+func (m AgendaViewModel) View() string {
+	var content strings.Builder
+
+	content.WriteString(titleStyle.Render(withIcon(glyphs.Agenda, "Today — "+time.Now().Format("Monday, January 2"))))
+	content.WriteByte('\n')
+	content.WriteString(agendaSummaryStyle.Render(
+		withIcon(glyphs.Summary, fmt.Sprintf("%d of %d tasks due today completed %s %d completed today",
+			m.dueDoneToday, m.dueToday, glyphs.ActionSeparator, m.completedToday)),
+	))
+	content.WriteByte('\n')
+	content.WriteString(m.renderCapacity())
+	content.WriteByte('\n')
+
+	// Sections and tasks are laid out as lines first so long agendas scroll
+	// with the cursor
+	var lines []string
+	cursorLine := 0
+	idx := 0
+	for _, section := range m.sections {
+		style := agendaSectionStyle
+		if section.overdue && len(section.tasks) > 0 {
+			style = agendaOverdueSectionStyle
+		}
+		lines = append(lines, strings.Split(style.Render(fmt.Sprintf("%s (%d)", section.title, len(section.tasks))), "\n")...)
+
+		if len(section.tasks) == 0 {
+			lines = append(lines, agendaEmptyStyle.Render("Nothing here"))
+		}
+
+		for _, task := range section.tasks {
+			if idx == m.cursor {
+				cursorLine = len(lines)
+			}
+			lines = append(lines, m.renderItem(task, idx == m.cursor))
+			idx++
+		}
+	}
+
+	start, end := m.window(len(lines), cursorLine)
+	for _, line := range lines[start:end] {
+		content.WriteString(line)
+		content.WriteByte('\n')
+	}
+
+	content.WriteByte('\n')
+	content.WriteString(statusStyle.Render(shortHint(keys.Agenda.ShortHelp())))
+
+	return baseStyle.
+		Width(m.width - 2).
+		Height(m.height - 2).
+		Render(content.String())
+}
+
+// window returns the range of agenda lines that fit on screen around the
+// line the cursor is on
+func (m AgendaViewModel) window(lines, cursorLine int) (int, int) {
+	// Leave room for the frame, the title, the summary, the capacity and the status line
+	height := lines
+	if m.height > 0 {
+		height = max(m.height-8, 1)
+	}
+	start := max(cursorLine-height+1, 0)
+	return start, min(start+height, lines)
+}
+
+func (m AgendaViewModel) renderItem(task models.Task, selected bool) string {
+	due := agendaDueStyle.Render(task.DueDate.Format("Mon Jan 2"))
+	if selected {
+		return agendaSelectedItemStyle.Render(fmt.Sprintf("%-12s %s  %s",
+			priorityLabel(task.Priority), task.Title, task.DueDate.Format("Mon Jan 2")))
+	}
+
+	priority := lipgloss.NewStyle().
+		Foreground(theme.PriorityColor(task.Priority)).
+		Width(12).
+		Render(priorityLabel(task.Priority))
+	return agendaItemStyle.Render(priority + " " + task.Title + "  " + due)
+}
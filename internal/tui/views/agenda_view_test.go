@@ -0,0 +1,87 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+// inLocation runs the rest of the test with name as the local time zone
+func inLocation(t *testing.T, name string) {
+	t.Helper()
+	loc, err := time.LoadLocation(name)
+	if err != nil {
+		t.Skip(err)
+	}
+	local := time.Local
+	time.Local = loc
+	t.Cleanup(func() { time.Local = local })
+}
+
+func TestAgendaSectionsByLocalDay(t *testing.T) {
+	for _, name := range []string{"America/Los_Angeles", "UTC", "Asia/Tokyo"} {
+		t.Run(name, func(t *testing.T) {
+			inLocation(t, name)
+
+			// Due dates are saved as midnight UTC of the chosen day
+			y, mo, d := time.Now().Date()
+			day := func(offset int) time.Time {
+				return time.Date(y, mo, d+offset, 0, 0, 0, 0, time.UTC)
+			}
+			m := NewAgendaViewModel()
+			m.UpdateTasks([]models.Task{
+				models.NewTask("yesterday", "", day(-1), models.Low),
+				models.NewTask("today", "", day(0), models.Low),
+				models.NewTask("tomorrow", "", day(1), models.Low),
+			})
+
+			want := map[string]string{"Overdue": "yesterday", "Today": "today", "Next 7 days": "tomorrow"}
+			for _, section := range m.sections {
+				if len(section.tasks) != 1 || section.tasks[0].Title != want[section.title] {
+					t.Errorf("%s holds %v, want %s", section.title, section.tasks, want[section.title])
+				}
+			}
+			if m.dueToday != 1 {
+				t.Errorf("dueToday = %d, want 1", m.dueToday)
+			}
+		})
+	}
+}
+
+func TestAgendaLeavesOutUndatedTasks(t *testing.T) {
+	m := NewAgendaViewModel()
+	m.UpdateTasks([]models.Task{models.NewTask("someday", "", time.Time{}, models.Low)})
+	if n := m.itemCount(); n != 0 {
+		t.Fatalf("agenda lists %d undated tasks", n)
+	}
+}
+
+func TestAgendaScrollsWithCursor(t *testing.T) {
+	y, mo, d := time.Now().Date()
+	today := time.Date(y, mo, d, 0, 0, 0, 0, time.UTC)
+	var tasks []models.Task
+	for i := range 30 {
+		tasks = append(tasks, models.NewTask(fmt.Sprintf("task %02d", i), "", today, models.Low))
+	}
+	m := NewAgendaViewModel()
+	m.UpdateTasks(tasks)
+	model, _ := m.Update(tea.WindowSizeMsg{Width: 80, Height: 20})
+	m = model.(AgendaViewModel)
+	for range 29 {
+		model, _ = m.Update(tea.KeyMsg{Type: tea.KeyDown})
+		m = model.(AgendaViewModel)
+	}
+
+	view := m.View()
+	last, _ := m.SelectedTask()
+	if !strings.Contains(view, last.Title) {
+		t.Errorf("selected %q is off screen", last.Title)
+	}
+	if lines := strings.Count(view, "\n") + 1; lines > 20 {
+		t.Errorf("agenda is %d lines tall on a 20 line screen", lines)
+	}
+}
@@ -0,0 +1,321 @@
+package views
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+	"github.com/sabry-awad97/task-manager/internal/tui/zone"
+)
+
+var (
+	archiveItemStyle         lipgloss.Style
+	archiveSelectedItemStyle lipgloss.Style
+	archiveInfoStyle         lipgloss.Style
+	archiveTagStyle          lipgloss.Style
+	archiveEmptyStyle        lipgloss.Style
+)
+
+// setArchiveStyles derives the archive view's styles from t
+func setArchiveStyles(t Theme) {
+	archiveItemStyle = lipgloss.NewStyle().
+		PaddingLeft(2)
+
+	archiveSelectedItemStyle = archiveItemStyle.
+		Background(t.Accent).
+		Foreground(t.OnAccent)
+
+	archiveInfoStyle = lipgloss.NewStyle().
+		Foreground(t.Muted)
+
+	archiveTagStyle = lipgloss.NewStyle().
+		Foreground(t.Secondary)
+
+	archiveEmptyStyle = lipgloss.NewStyle().
+		Foreground(t.Muted).
+		Italic(true).
+		PaddingLeft(2)
+}
+
+type archiveKeyMap struct {
+	Up        key.Binding
+	Down      key.Binding
+	Unarchive key.Binding
+	Search    key.Binding
+	Back      key.Binding
+
+	// While searching, when other keys are typed into the search field
+	SearchUp   key.Binding
+	SearchDown key.Binding
+}
+
+func (k archiveKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Search, k.Unarchive, k.Back}
+}
+
+func (k archiveKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Search, k.SearchUp, k.SearchDown},
+		{k.Unarchive},
+		{k.Back},
+	}
+}
+
+// ArchiveTasksMsg moves tasks out of the task list into the archive
+type ArchiveTasksMsg struct {
+	TaskIDs []string
+}
+
+// UnarchiveTasksMsg brings archived tasks back into the task list
+type UnarchiveTasksMsg struct {
+	TaskIDs []string
+}
+
+type ArchiveViewModel struct {
+	tasks        []models.Task // Every archived task, most recently completed first
+	matches      []models.Task // Tasks matching the search
+	cursor       int
+	search       textinput.Model
+	searching    bool
+	width        int
+	height       int
+	shouldReturn bool
+}
+
+func NewArchiveViewModel() ArchiveViewModel {
+	search := textinput.New()
+	search.Prompt = "/"
+	search.Placeholder = "search title, description or tags"
+	search.Cursor.Style = cursorStyle
+	return ArchiveViewModel{search: search}
+}
+
+func (m ArchiveViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m ArchiveViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		m.search.Width = max(msg.Width-8, 10)
+
+	case tea.KeyMsg:
+		if m.searching {
+			return m.updateSearch(msg)
+		}
+		switch {
+		case key.Matches(msg, keys.Archive.Back):
+			m.shouldReturn = true
+			m.search.SetValue("")
+		case key.Matches(msg, keys.Archive.Up):
+			m.cursor = max(m.cursor-1, 0)
+		case key.Matches(msg, keys.Archive.Down):
+			m.cursor = max(min(m.cursor+1, len(m.matches)-1), 0)
+		case key.Matches(msg, keys.Archive.Search):
+			m.searching = true
+			return m, m.search.Focus()
+		case key.Matches(msg, keys.Archive.Unarchive):
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return UnarchiveTasksMsg{TaskIDs: []string{task.ID}}
+				}
+			}
+		}
+
+	case tea.MouseMsg:
+		if msg.Action != tea.MouseActionPress {
+			break
+		}
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.cursor = max(m.cursor-wheelStep, 0)
+		case tea.MouseButtonWheelDown:
+			m.cursor = max(min(m.cursor+wheelStep, len(m.matches)-1), 0)
+		case tea.MouseButtonLeft:
+			start, end := m.window()
+			for i := start; i < end; i++ {
+				if zone.Get(archiveRowZone(i)).InBounds(msg) {
+					m.cursor = i
+				}
+			}
+		}
+
+	default:
+		// Cursor blink for the search field
+		if m.searching {
+			var cmd tea.Cmd
+			m.search, cmd = m.search.Update(msg)
+			return m, cmd
+		}
+	}
+	return m, nil
+}
+
+// updateSearch feeds a key to the search field, filtering as the user types.
+// Submit keeps the filter, cancel clears it.
+func (m ArchiveViewModel) updateSearch(msg tea.KeyMsg) (ArchiveViewModel, tea.Cmd) {
+	switch {
+	case key.Matches(msg, keys.Prompt.Submit):
+		m.searching = false
+		m.search.Blur()
+		return m, nil
+	case key.Matches(msg, keys.Prompt.Cancel):
+		m.searching = false
+		m.search.Blur()
+		m.search.SetValue("")
+		m.filter()
+		return m, nil
+	case key.Matches(msg, keys.Archive.SearchUp):
+		m.cursor = max(m.cursor-1, 0)
+		return m, nil
+	case key.Matches(msg, keys.Archive.SearchDown):
+		m.cursor = max(min(m.cursor+1, len(m.matches)-1), 0)
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.search, cmd = m.search.Update(msg)
+	m.filter()
+	return m, cmd
+}
+
+// Capturing reports whether the search field is reading text and needs every key
+func (m ArchiveViewModel) Capturing() bool {
+	return m.searching
+}
+
+// UpdateTasks lists the archived tasks, most recently completed first
+func (m *ArchiveViewModel) UpdateTasks(tasks []models.Task) {
+	m.tasks = slices.Clone(tasks)
+	slices.SortStableFunc(m.tasks, func(a, b models.Task) int {
+		return completedAt(b).Compare(completedAt(a))
+	})
+	m.filter()
+}
+
+// filter keeps the tasks whose title, description or tags contain the search
+func (m *ArchiveViewModel) filter() {
+	query := strings.ToLower(strings.TrimSpace(m.search.Value()))
+	m.matches = nil
+	for _, task := range m.tasks {
+		text := strings.ToLower(task.Title + "\n" + task.Description + "\n" + strings.Join(task.Tags, "\n"))
+		if strings.Contains(text, query) {
+			m.matches = append(m.matches, task)
+		}
+	}
+	m.cursor = max(min(m.cursor, len(m.matches)-1), 0)
+}
+
+// completedAt is when the task was done, or the zero time when unknown
+func completedAt(task models.Task) time.Time {
+	if task.CompletedAt == nil {
+		return time.Time{}
+	}
+	return *task.CompletedAt
+}
+
+func (m ArchiveViewModel) SelectedTask() (models.Task, bool) {
+	if m.cursor < 0 || m.cursor >= len(m.matches) {
+		return models.Task{}, false
+	}
+	return m.matches[m.cursor], true
+}
+
+func (m ArchiveViewModel) ShouldReturn() bool {
+	return m.shouldReturn
+}
+
+// ResetReturn clears the return request so the archive can be shown again
+func (m *ArchiveViewModel) ResetReturn() {
+	m.shouldReturn = false
+}
+
+func archiveRowZone(i int) string {
+	return fmt.Sprintf("archive.row.%d", i)
+}
+
+// window returns the range of matches that fit on screen around the cursor
+func (m ArchiveViewModel) window() (int, int) {
+	// Leave room for the frame, the title, the search line and the status line
+	height := len(m.matches)
+	if m.height > 0 {
+		height = max(m.height-9, 1)
+	}
+	start := max(m.cursor-height+1, 0)
+	return start, min(start+height, len(m.matches))
+}
+
+func (m ArchiveViewModel) View() string {
+	var content strings.Builder
+
+	content.WriteString(titleStyle.Render(withIcon(glyphs.Archive, "Archive")))
+	content.WriteByte('\n')
+
+	if m.searching || m.search.Value() != "" {
+		content.WriteString(m.search.View())
+	} else {
+		content.WriteString(archiveInfoStyle.Render(fmt.Sprintf("%d archived tasks", len(m.tasks))))
+	}
+	content.WriteString("\n\n")
+
+	if len(m.matches) == 0 {
+		empty := "Archive is empty"
+		if len(m.tasks) > 0 {
+			empty = "No archived task matches"
+		}
+		content.WriteString(archiveEmptyStyle.Render(empty))
+		content.WriteByte('\n')
+	}
+
+	start, end := m.window()
+	for i := start; i < end; i++ {
+		content.WriteString(zone.Mark(archiveRowZone(i), m.renderItem(m.matches[i], i == m.cursor)))
+		content.WriteByte('\n')
+	}
+
+	content.WriteByte('\n')
+	content.WriteString(statusStyle.Render(shortHint(keys.Archive.ShortHelp())))
+
+	return baseStyle.
+		Width(m.width - 2).
+		Height(m.height - 2).
+		Render(content.String())
+}
+
+func (m ArchiveViewModel) renderItem(task models.Task, selected bool) string {
+	when := "not completed"
+	if task.Completed {
+		when = "completed"
+		if task.CompletedAt != nil {
+			when += " " + task.CompletedAt.Format("2006-01-02")
+		}
+	}
+	tags := ""
+	if len(task.Tags) > 0 {
+		tags = "#" + strings.Join(task.Tags, " #")
+	}
+
+	if selected {
+		line := task.Title + "  " + when
+		if tags != "" {
+			line += "  " + tags
+		}
+		if glyphs.Markers {
+			line = cursorMarker + " " + line
+		}
+		return archiveSelectedItemStyle.Render(line)
+	}
+	line := task.Title + "  " + archiveInfoStyle.Render(when)
+	if tags != "" {
+		line += "  " + archiveTagStyle.Render(tags)
+	}
+	return archiveItemStyle.Render(line)
+}
@@ -0,0 +1,51 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+func TestArchiveSearchKeysCanBeRemapped(t *testing.T) {
+	for _, preset := range KeyPresets() {
+		if _, err := NewKeyMap(preset, nil); err != nil {
+			t.Errorf("%s: %v", preset, err)
+		}
+	}
+	remapped, err := NewKeyMap("default", map[string][]string{
+		"archive.search_up":   {"ctrl+k"},
+		"archive.search_down": {"ctrl+j"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := keys
+	SetKeyMap(remapped)
+	t.Cleanup(func() { SetKeyMap(saved) })
+
+	m := NewArchiveViewModel()
+	m.UpdateTasks([]models.Task{
+		models.NewTask("a", "", time.Time{}, models.Low),
+		models.NewTask("b", "", time.Time{}, models.Low),
+	})
+	press := func(msg tea.KeyMsg) {
+		next, _ := m.Update(msg)
+		m = next.(ArchiveViewModel)
+	}
+	press(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("/")})
+	if !m.Capturing() {
+		t.Fatal("search did not open")
+	}
+
+	press(tea.KeyMsg{Type: tea.KeyCtrlJ})
+	if m.cursor != 1 {
+		t.Errorf("after ctrl+j cursor = %d, want 1", m.cursor)
+	}
+	press(tea.KeyMsg{Type: tea.KeyDown})
+	press(tea.KeyMsg{Type: tea.KeyCtrlK})
+	if m.cursor != 0 {
+		t.Errorf("after ctrl+k cursor = %d, want 0", m.cursor)
+	}
+}
@@ -0,0 +1,410 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+	"github.com/sabry-awad97/task-manager/internal/tui/zone"
+)
+
+var (
+	boardColumnStyle       lipgloss.Style
+	activeBoardColumnStyle lipgloss.Style
+	boardColumnTitleStyle  lipgloss.Style
+	cardStyle              lipgloss.Style
+	selectedCardStyle      lipgloss.Style
+	cardTitleStyle         lipgloss.Style
+	cardDueStyle           lipgloss.Style
+)
+
+// setBoardStyles derives the board's styles from t
+func setBoardStyles(t Theme) {
+	boardColumnStyle = lipgloss.NewStyle().
+		Border(glyphs.Border).
+		BorderForeground(t.Border).
+		Padding(0, 1)
+
+	activeBoardColumnStyle = boardColumnStyle.
+		BorderForeground(t.Secondary)
+
+	boardColumnTitleStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Secondary).
+		MarginBottom(1)
+
+	cardStyle = lipgloss.NewStyle().
+		Border(glyphs.Border).
+		BorderForeground(t.Border).
+		Padding(0, 1)
+
+	selectedCardStyle = cardStyle.
+		BorderForeground(t.Accent)
+
+	cardTitleStyle = lipgloss.NewStyle().
+		Bold(true)
+
+	cardDueStyle = lipgloss.NewStyle().
+		Foreground(t.Muted)
+}
+
+// cardHeight is the card border plus its title and meta lines
+const cardHeight = 4
+
+// minColumnWidth is the narrowest a column gets before the board scrolls sideways
+const minColumnWidth = 28
+
+type boardKeyMap struct {
+	Up        key.Binding
+	Down      key.Binding
+	Left      key.Binding
+	Right     key.Binding
+	MoveLeft  key.Binding
+	MoveRight key.Binding
+	Enter     key.Binding
+	Back      key.Binding
+}
+
+func (k boardKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.MoveLeft, k.MoveRight, k.Enter, k.Back}
+}
+
+func (k boardKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Left, k.Right, k.Enter},
+		{k.MoveLeft, k.MoveRight, keys.Main.New},
+		{k.Back},
+	}
+}
+
+// boardColumn holds the tasks in one workflow state
+type boardColumn struct {
+	status models.Status
+	tasks  []models.Task
+}
+
+type BoardViewModel struct {
+	columns      []boardColumn
+	column       int
+	first        int // Leftmost column on screen when they do not all fit
+	cursor       []int
+	width        int
+	height       int
+	dragging     bool
+	dragColumn   int
+	notice       string // Why the last move was refused
+	shouldReturn bool
+}
+
+// NewBoardViewModel lays out one column per state of the live workflow
+func NewBoardViewModel() BoardViewModel {
+	columns := make([]boardColumn, len(workflow.States))
+	for i, status := range workflow.States {
+		columns[i].status = status
+	}
+	return BoardViewModel{
+		columns: columns,
+		// Start where new tasks land
+		column: max(workflow.Index(workflow.Initial), 0),
+		cursor: make([]int, len(columns)),
+	}
+}
+
+func (m BoardViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m BoardViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		m.notice = ""
+		switch {
+		case key.Matches(msg, keys.Board.Back):
+			m.shouldReturn = true
+		case key.Matches(msg, keys.Board.Up):
+			if m.cursor[m.column] > 0 {
+				m.cursor[m.column]--
+			}
+		case key.Matches(msg, keys.Board.Down):
+			if m.cursor[m.column] < len(m.columns[m.column].tasks)-1 {
+				m.cursor[m.column]++
+			}
+		case key.Matches(msg, keys.Board.Left):
+			m.column = (m.column - 1 + len(m.columns)) % len(m.columns)
+		case key.Matches(msg, keys.Board.Right):
+			m.column = (m.column + 1) % len(m.columns)
+		case key.Matches(msg, keys.Board.MoveLeft):
+			cmd := m.moveSelected(m.column - 1)
+			m.follow()
+			return m, cmd
+		case key.Matches(msg, keys.Board.MoveRight):
+			cmd := m.moveSelected(m.column + 1)
+			m.follow()
+			return m, cmd
+		case key.Matches(msg, keys.Board.Enter):
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return ShowDetailMsg{Task: task}
+				}
+			}
+		}
+
+	case tea.MouseMsg:
+		switch msg.Action {
+		case tea.MouseActionPress:
+			if msg.Button != tea.MouseButtonLeft {
+				break
+			}
+			m.notice = ""
+			col := m.columnAt(msg)
+			if col < 0 {
+				break
+			}
+			m.column = col
+			if idx := m.cardAt(col, msg); idx >= 0 {
+				m.cursor[col] = idx
+				m.dragging = true
+				m.dragColumn = col
+			}
+
+		case tea.MouseActionRelease:
+			if !m.dragging {
+				break
+			}
+			m.dragging = false
+			if col := m.columnAt(msg); col >= 0 && col != m.dragColumn {
+				m.column = m.dragColumn
+				cmd := m.moveSelected(col)
+				m.follow()
+				return m, cmd
+			}
+		}
+	}
+	m.follow()
+	return m, nil
+}
+
+// follow scrolls the columns sideways so the active one is on screen
+func (m *BoardViewModel) follow() {
+	fit := m.fitColumns()
+	if m.column < m.first {
+		m.first = m.column
+	}
+	if m.column >= m.first+fit {
+		m.first = m.column - fit + 1
+	}
+	m.first = max(min(m.first, len(m.columns)-fit), 0)
+}
+
+// moveSelected emits the message that puts the selected card into the target column
+func (m *BoardViewModel) moveSelected(target int) tea.Cmd {
+	if target < 0 || target >= len(m.columns) || target == m.column {
+		return nil
+	}
+	task, ok := m.SelectedTask()
+	if !ok {
+		return nil
+	}
+	to := m.columns[target].status
+	if !workflow.CanMove(task.Status, to.ID) {
+		m.notice = fmt.Sprintf("%s tasks cannot move to %s", workflow.Status(task.Status).Name, to.Name)
+		return nil
+	}
+
+	// Follow the card so it stays selected once the tasks are reloaded
+	m.column = target
+	return func() tea.Msg {
+		return SetStatusMsg{TaskID: task.ID, Status: to.ID}
+	}
+}
+
+func (m *BoardViewModel) UpdateTasks(tasks []models.Task) {
+	var selectedID string
+	if task, ok := m.SelectedTask(); ok {
+		selectedID = task.ID
+	}
+
+	for i := range m.columns {
+		m.columns[i].tasks = nil
+	}
+	for _, task := range tasks {
+		// Tasks in states no longer configured wait in the initial column
+		i := workflow.Index(task.Status)
+		if i < 0 {
+			i = max(workflow.Index(workflow.Initial), 0)
+		}
+		if i < len(m.columns) {
+			m.columns[i].tasks = append(m.columns[i].tasks, task)
+		}
+	}
+
+	for i, col := range m.columns {
+		for j, task := range col.tasks {
+			if task.ID == selectedID {
+				m.cursor[i] = j
+			}
+		}
+		if m.cursor[i] >= len(col.tasks) {
+			m.cursor[i] = max(len(col.tasks)-1, 0)
+		}
+	}
+}
+
+func (m BoardViewModel) SelectedTask() (models.Task, bool) {
+	if len(m.columns) == 0 {
+		return models.Task{}, false
+	}
+	col := m.columns[m.column]
+	if len(col.tasks) == 0 {
+		return models.Task{}, false
+	}
+	return col.tasks[m.cursor[m.column]], true
+}
+
+func (m BoardViewModel) ShouldReturn() bool {
+	return m.shouldReturn
+}
+
+// ResetReturn clears the return request so the board can be shown again
+func (m *BoardViewModel) ResetReturn() {
+	m.shouldReturn = false
+}
+
+// fitColumns is how many columns fit side by side
+func (m BoardViewModel) fitColumns() int {
+	if m.width == 0 {
+		return len(m.columns)
+	}
+	return min(max((m.width-4)/minColumnWidth, 1), len(m.columns))
+}
+
+func (m BoardViewModel) columnWidth() int {
+	return max((m.width-4)/m.fitColumns(), 20)
+}
+
+func (m BoardViewModel) visibleCards() int {
+	return max((m.columnHeight()-2)/cardHeight, 1)
+}
+
+// columnHeight is the inner height of a column, leaving room for the status line
+func (m BoardViewModel) columnHeight() int {
+	return max(m.height-8, cardHeight+2)
+}
+
+// scrollOffset keeps the cursor of a column inside the visible card window
+func (m BoardViewModel) scrollOffset(col int) int {
+	visible := m.visibleCards()
+	if m.cursor[col] < visible {
+		return 0
+	}
+	return m.cursor[col] - visible + 1
+}
+
+// Clickable regions of the board
+func columnZone(col int) string {
+	return fmt.Sprintf("board.column.%d", col)
+}
+
+func cardZone(col, idx int) string {
+	return fmt.Sprintf("board.card.%d.%d", col, idx)
+}
+
+// columnAt returns the column under the mouse, or -1
+func (m BoardViewModel) columnAt(msg tea.MouseMsg) int {
+	for i := range m.columns {
+		if zone.Get(columnZone(i)).InBounds(msg) {
+			return i
+		}
+	}
+	return -1
+}
+
+// cardAt returns the index of the card under the mouse in col, or -1
+func (m BoardViewModel) cardAt(col int, msg tea.MouseMsg) int {
+	offset := m.scrollOffset(col)
+	end := min(offset+m.visibleCards(), len(m.columns[col].tasks))
+	for j := offset; j < end; j++ {
+		if zone.Get(cardZone(col, j)).InBounds(msg) {
+			return j
+		}
+	}
+	return -1
+}
+
+func (m BoardViewModel) View() string {
+	colWidth := m.columnWidth()
+
+	end := min(m.first+m.fitColumns(), len(m.columns))
+	rendered := make([]string, 0, end-m.first)
+	for i := m.first; i < end; i++ {
+		col := m.columns[i]
+		var b strings.Builder
+		b.WriteString(boardColumnTitleStyle.
+			Foreground(theme.StatusColor(col.status)).
+			Render(fmt.Sprintf("%s (%d)", withIcon(statusIcon(col.status), col.status.Name), len(col.tasks))))
+		b.WriteString("\n")
+
+		offset := m.scrollOffset(i)
+		end := min(offset+m.visibleCards(), len(col.tasks))
+		cards := make([]string, 0, end-offset)
+		for j := offset; j < end; j++ {
+			card := m.renderCard(col.tasks[j], colWidth-6, i == m.column && j == m.cursor[i])
+			cards = append(cards, zone.Mark(cardZone(i, j), card))
+		}
+		b.WriteString(strings.Join(cards, "\n"))
+
+		style := boardColumnStyle
+		if i == m.column {
+			style = activeBoardColumnStyle
+		}
+		rendered = append(rendered, zone.Mark(columnZone(i), style.
+			Width(colWidth-2).
+			Height(m.columnHeight()).
+			Render(b.String())))
+	}
+
+	content := strings.Builder{}
+	content.WriteString(titleStyle.Render(withIcon(glyphs.Board, "Board")))
+	content.WriteByte('\n')
+	content.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, rendered...))
+	content.WriteByte('\n')
+	status := shortHint(keys.Board.ShortHelp())
+	if m.first > 0 || end < len(m.columns) {
+		status = fmt.Sprintf("Columns %d-%d of %d • %s", m.first+1, end, len(m.columns), status)
+	}
+	if m.notice != "" {
+		status = m.notice
+	}
+	content.WriteString(statusStyle.Render(status))
+
+	return baseStyle.
+		Width(m.width - 2).
+		Height(m.height - 2).
+		Render(content.String())
+}
+
+func (m BoardViewModel) renderCard(task models.Task, width int, selected bool) string {
+	style := cardStyle
+	if selected {
+		style = selectedCardStyle
+	}
+
+	priority := lipgloss.NewStyle().
+		Foreground(theme.PriorityColor(task.Priority)).
+		Render(withIcon(glyphs.Bullet, task.Priority.String()))
+	if glyphs.Markers {
+		priority = priorityLabel(task.Priority)
+	}
+
+	title := cardTitleStyle.MaxWidth(width - 2).Render(task.Title)
+	meta := priority + "  " + cardDueStyle.Render(formatDue(task.DueDate, "2006-01-02"))
+
+	return style.Width(width).Render(title + "\n" + meta)
+}
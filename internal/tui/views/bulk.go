@@ -0,0 +1,313 @@
+package views
+
+import (
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+var (
+	markedRowStyle lipgloss.Style
+	promptStyle    lipgloss.Style
+)
+
+// setBulkStyles derives the selection and prompt styles from t
+func setBulkStyles(t Theme) {
+	markedRowStyle = lipgloss.NewStyle().
+		Background(t.Marked)
+
+	promptStyle = lipgloss.NewStyle().
+		Foreground(t.Accent).
+		Bold(true)
+}
+
+type BulkAction int
+
+const (
+	BulkAdvance BulkAction = iota
+	BulkComplete
+	BulkDelete
+	BulkSetPriority
+	BulkAddTag
+	BulkReschedule
+	BulkSnooze
+)
+
+func (a BulkAction) String() string {
+	return [...]string{"advance", "complete", "delete", "set priority", "add tag", "reschedule", "snooze"}[a]
+}
+
+// BulkActionMsg applies one action to several tasks as a single undoable step
+type BulkActionMsg struct {
+	Action   BulkAction
+	TaskIDs  []string
+	Priority models.PriorityLevel
+	Tag      string
+	DueDate  time.Time
+	Until    time.Time // Snooze end; zero wakes the tasks
+}
+
+// UndoMsg reverts the most recent bulk action
+type UndoMsg struct{}
+
+// newPrompt builds the single-line input used to collect a bulk action argument
+func newPrompt(action BulkAction) textinput.Model {
+	prompt := textinput.New()
+	prompt.Prompt = promptStyle.Render(action.String()+": ") + " "
+	prompt.CharLimit = 30
+	prompt.Width = 30
+	prompt.Cursor.Style = cursorStyle
+
+	switch action {
+	case BulkSetPriority:
+		prompt.Placeholder = priorityNames()
+	case BulkAddTag:
+		prompt.Placeholder = "tag name"
+	case BulkReschedule:
+		prompt.Placeholder = "YYYY-MM-DD, +3d or +1w"
+	case BulkSnooze:
+		prompt.Placeholder = snoozeChoices
+	}
+	prompt.Focus()
+	return prompt
+}
+
+// bulkMsg turns the prompt answer into a bulk action for ids
+func bulkMsg(action BulkAction, ids []string, input string) (BulkActionMsg, error) {
+	msg := BulkActionMsg{Action: action, TaskIDs: ids}
+	input = strings.TrimSpace(input)
+
+	switch action {
+	case BulkSetPriority:
+		priority, err := parsePriority(input)
+		if err != nil {
+			return msg, err
+		}
+		msg.Priority = priority
+	case BulkAddTag:
+		if input == "" {
+			return msg, fmt.Errorf("tag must not be empty")
+		}
+		msg.Tag = input
+	case BulkReschedule:
+		due, err := parseDueInput(input, time.Now())
+		if err != nil {
+			return msg, err
+		}
+		msg.DueDate = due
+	case BulkSnooze:
+		until, err := parseSnooze(input, time.Now())
+		if err != nil {
+			return msg, err
+		}
+		msg.Until = until
+	}
+	return msg, nil
+}
+
+// parsePriority finds a level of the scale by its name or by the start of
+// a name no other level shares
+func parsePriority(s string) (models.PriorityLevel, error) {
+	s = strings.ToLower(s)
+	if s == "" {
+		return 0, fmt.Errorf("priority must not be empty")
+	}
+	match := models.PriorityLevel(-1)
+	for i, p := range models.PriorityScale() {
+		name := strings.ToLower(p.Name)
+		if name == s {
+			return models.PriorityLevel(i), nil
+		}
+		if strings.HasPrefix(name, s) {
+			if match >= 0 {
+				return 0, fmt.Errorf("priority %q is ambiguous", s)
+			}
+			match = models.PriorityLevel(i)
+		}
+	}
+	if match < 0 {
+		return 0, fmt.Errorf("unknown priority %q", s)
+	}
+	return match, nil
+}
+
+// priorityNames lists the scale for prompts, e.g. "low, medium or high"
+func priorityNames() string {
+	var names []string
+	for _, p := range models.PriorityScale() {
+		names = append(names, strings.ToLower(p.Name))
+	}
+	if len(names) < 2 {
+		return strings.Join(names, "")
+	}
+	return strings.Join(names[:len(names)-1], ", ") + " or " + names[len(names)-1]
+}
+
+// parseDueInput accepts an absolute date or an offset from today such as +3d or +1w
+func parseDueInput(s string, now time.Time) (time.Time, error) {
+	if strings.HasPrefix(s, "+") && len(s) > 2 {
+		n, err := strconv.Atoi(s[1 : len(s)-1])
+		if err != nil {
+			return time.Time{}, fmt.Errorf("invalid offset %q", s)
+		}
+		today := truncateDay(now)
+		switch s[len(s)-1] {
+		case 'd':
+			return today.AddDate(0, 0, n), nil
+		case 'w':
+			return today.AddDate(0, 0, 7*n), nil
+		}
+		return time.Time{}, fmt.Errorf("invalid offset %q", s)
+	}
+
+	due, err := time.Parse("2006-01-02", s)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid date format (YYYY-MM-DD)")
+	}
+	return due, nil
+}
+
+// snoozeChoices are the quick answers to the snooze prompt
+const snoozeChoices = "1h, tomorrow or next week"
+
+// parseSnooze reads how long to hide tasks for: one of snoozeChoices or the
+// start of a word of them, a duration such as 3h, or a day as parseDueInput
+// reads it
+func parseSnooze(s string, now time.Time) (time.Time, error) {
+	s = strings.ToLower(s)
+	today := truncateDay(now)
+	switch {
+	case s == "":
+		return time.Time{}, fmt.Errorf("choose %s", snoozeChoices)
+	case strings.HasPrefix("tomorrow", s):
+		return today.AddDate(0, 0, 1), nil
+	case strings.HasPrefix("next week", s), s == "week":
+		// The Monday after today
+		days := (8 - int(today.Weekday())) % 7
+		if days == 0 {
+			days = 7
+		}
+		return today.AddDate(0, 0, days), nil
+	}
+	if d, err := time.ParseDuration(s); err == nil && d > 0 {
+		return now.Add(d), nil
+	}
+	day, err := parseDueInput(s, now)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("choose %s, or a date", snoozeChoices)
+	}
+	// Wake at the start of the local day
+	return time.Date(day.Year(), day.Month(), day.Day(), 0, 0, 0, 0, time.Local), nil
+}
+
+// updatePrompt feeds a key to the open bulk prompt and submits it on enter
+func (m MainViewModel) updatePrompt(msg tea.KeyMsg) (MainViewModel, tea.Cmd) {
+	switch {
+	case key.Matches(msg, keys.Prompt.Cancel):
+		m.prompting = false
+		m.promptErr = ""
+		return m, nil
+	case key.Matches(msg, keys.Prompt.Submit):
+		bulk, err := bulkMsg(m.promptAction, m.targetIDs(), m.prompt.Value())
+		if err != nil {
+			m.promptErr = err.Error()
+			return m, nil
+		}
+		m.prompting = false
+		m.promptErr = ""
+		m.clearSelection()
+		return m, func() tea.Msg { return bulk }
+	}
+
+	var cmd tea.Cmd
+	m.prompt, cmd = m.prompt.Update(msg)
+	return m, cmd
+}
+
+// Capturing reports whether the main view is reading text and needs every key
+func (m MainViewModel) Capturing() bool {
+	return m.prompting
+}
+
+func (m *MainViewModel) openPrompt(action BulkAction) {
+	m.prompting = true
+	m.promptAction = action
+	m.promptErr = ""
+	m.prompt = newPrompt(action)
+}
+
+// targetIDs returns the marked tasks, or the task under the cursor when none are marked
+func (m MainViewModel) targetIDs() []string {
+	var ids []string
+	for i, task := range m.tasks {
+		if m.isMarked(i) {
+			ids = append(ids, task.ID)
+		}
+	}
+	if len(ids) == 0 {
+		if task, ok := m.SelectedTask(); ok {
+			ids = append(ids, task.ID)
+		}
+	}
+	return ids
+}
+
+// isMarked reports whether row i is marked or inside the active visual range
+func (m MainViewModel) isMarked(i int) bool {
+	if i >= len(m.tasks) {
+		return false
+	}
+	if m.marked[m.tasks[i].ID] {
+		return true
+	}
+	if !m.visual {
+		return false
+	}
+	lo, hi := m.anchor, m.table.Cursor()
+	if lo > hi {
+		lo, hi = hi, lo
+	}
+	return i >= lo && i <= hi
+}
+
+func (m MainViewModel) markedCount() int {
+	n := 0
+	for i := range m.tasks {
+		if m.isMarked(i) {
+			n++
+		}
+	}
+	return n
+}
+
+// commitVisual turns the visual range into regular marks
+func (m *MainViewModel) commitVisual() {
+	for i, task := range m.tasks {
+		if m.isMarked(i) {
+			m.marked[task.ID] = true
+		}
+	}
+	m.visual = false
+}
+
+func (m *MainViewModel) clearSelection() {
+	m.marked = make(map[string]bool)
+	m.visual = false
+}
+
+// bulkCmd emits action for the marked tasks, or reports false when nothing is marked
+func (m *MainViewModel) bulkCmd(action BulkAction) (tea.Cmd, bool) {
+	if m.markedCount() == 0 {
+		return nil, false
+	}
+	msg := BulkActionMsg{Action: action, TaskIDs: m.targetIDs()}
+	m.clearSelection()
+	return func() tea.Msg { return msg }, true
+}
@@ -0,0 +1,47 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+func TestBulkMsgReadsTheArgument(t *testing.T) {
+	ids := []string{"a", "b"}
+
+	msg, err := bulkMsg(BulkSetPriority, ids, " hi ")
+	if err != nil || msg.Priority != models.High {
+		t.Errorf("priority %q: %v, %v", "hi", msg.Priority, err)
+	}
+	msg, err = bulkMsg(BulkAddTag, ids, "home")
+	if err != nil || msg.Tag != "home" {
+		t.Errorf("tag: %q, %v", msg.Tag, err)
+	}
+	msg, err = bulkMsg(BulkReschedule, ids, "2026-11-01")
+	if y, m, d := msg.DueDate.Date(); err != nil || y != 2026 || m != time.November || d != 1 {
+		t.Errorf("reschedule to a date: %v, %v", msg.DueDate, err)
+	}
+	msg, err = bulkMsg(BulkReschedule, ids, "+1w")
+	if want := truncateDay(time.Now()).AddDate(0, 0, 7); err != nil || !msg.DueDate.Equal(want) {
+		t.Errorf("reschedule a week out: %v, %v, want %v", msg.DueDate, err, want)
+	}
+	if len(msg.TaskIDs) != len(ids) {
+		t.Errorf("message holds %v, want %v", msg.TaskIDs, ids)
+	}
+
+	for _, tt := range []struct {
+		action BulkAction
+		input  string
+	}{
+		{BulkSetPriority, ""},
+		{BulkSetPriority, "urgent"},
+		{BulkAddTag, "  "},
+		{BulkReschedule, "tomorrow-ish"},
+		{BulkReschedule, "+3x"},
+	} {
+		if _, err := bulkMsg(tt.action, ids, tt.input); err == nil {
+			t.Errorf("%s %q: no error", tt.action, tt.input)
+		}
+	}
+}
@@ -0,0 +1,411 @@
+package views
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+var (
+	calendarDayStyle       lipgloss.Style
+	calendarCursorDayStyle lipgloss.Style
+	calendarDropDayStyle   lipgloss.Style
+	calendarWeekdayStyle   lipgloss.Style
+	calendarDayNumberStyle lipgloss.Style
+	calendarTodayStyle     lipgloss.Style
+	calendarOutsideStyle   lipgloss.Style
+	calendarTaskStyle      lipgloss.Style
+	calendarDoneStyle      lipgloss.Style
+	calendarListStyle      lipgloss.Style
+)
+
+// setCalendarStyles derives the calendar's styles from t
+func setCalendarStyles(t Theme) {
+	calendarDayStyle = lipgloss.NewStyle().
+		Border(glyphs.Grid).
+		BorderForeground(t.Border).
+		Padding(0, 1)
+
+	calendarCursorDayStyle = calendarDayStyle.
+		BorderForeground(t.Accent)
+
+	calendarDropDayStyle = calendarDayStyle.
+		BorderForeground(t.Warning)
+
+	calendarWeekdayStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Secondary).
+		Align(lipgloss.Center)
+
+	calendarDayNumberStyle = lipgloss.NewStyle().
+		Bold(true)
+
+	calendarTodayStyle = calendarDayNumberStyle.
+		Foreground(t.Accent)
+
+	calendarOutsideStyle = lipgloss.NewStyle().
+		Foreground(t.Border)
+
+	calendarTaskStyle = lipgloss.NewStyle().
+		Foreground(t.Text)
+
+	calendarDoneStyle = lipgloss.NewStyle().
+		Foreground(t.Muted).
+		Strikethrough(true)
+
+	calendarListStyle = lipgloss.NewStyle().
+		Border(glyphs.Border).
+		BorderForeground(t.Accent).
+		Padding(0, 1)
+}
+
+type CalendarMode int
+
+const (
+	MonthMode CalendarMode = iota
+	WeekMode
+)
+
+type calendarKeyMap struct {
+	Left      key.Binding
+	Right     key.Binding
+	Up        key.Binding
+	Down      key.Binding
+	PrevMonth key.Binding
+	NextMonth key.Binding
+	Today     key.Binding
+	Mode      key.Binding
+	Enter     key.Binding
+	Move      key.Binding
+	Back      key.Binding
+}
+
+func (k calendarKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.PrevMonth, k.NextMonth, k.Mode, k.Enter, k.Back}
+}
+
+func (k calendarKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Left, k.Right, k.Up, k.Down, k.PrevMonth, k.NextMonth, k.Today, k.Enter},
+		{k.Move},
+		{k.Mode, k.Back},
+	}
+}
+
+// RescheduleTaskMsg moves a task to another due date
+type RescheduleTaskMsg struct {
+	TaskID  string
+	DueDate time.Time
+}
+
+type CalendarViewModel struct {
+	tasks        []models.Task
+	mode         CalendarMode
+	cursor       time.Time
+	listing      bool // Day task list has focus
+	listCursor   int
+	moving       *models.Task
+	width        int
+	height       int
+	shouldReturn bool
+}
+
+func NewCalendarViewModel() CalendarViewModel {
+	return CalendarViewModel{
+		cursor: truncateDay(time.Now()),
+	}
+}
+
+func (m CalendarViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m CalendarViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		if m.listing {
+			return m.updateList(msg)
+		}
+
+		switch {
+		case key.Matches(msg, keys.Calendar.Back):
+			if m.moving != nil {
+				m.moving = nil
+				return m, nil
+			}
+			m.shouldReturn = true
+		case key.Matches(msg, keys.Calendar.Left):
+			m.cursor = m.cursor.AddDate(0, 0, -1)
+		case key.Matches(msg, keys.Calendar.Right):
+			m.cursor = m.cursor.AddDate(0, 0, 1)
+		case key.Matches(msg, keys.Calendar.Up):
+			m.cursor = m.cursor.AddDate(0, 0, -7)
+		case key.Matches(msg, keys.Calendar.Down):
+			m.cursor = m.cursor.AddDate(0, 0, 7)
+		case key.Matches(msg, keys.Calendar.PrevMonth):
+			m.cursor = m.cursor.AddDate(0, -1, 0)
+		case key.Matches(msg, keys.Calendar.NextMonth):
+			m.cursor = m.cursor.AddDate(0, 1, 0)
+		case key.Matches(msg, keys.Calendar.Today):
+			m.cursor = truncateDay(time.Now())
+		case key.Matches(msg, keys.Calendar.Mode):
+			if m.mode == MonthMode {
+				m.mode = WeekMode
+			} else {
+				m.mode = MonthMode
+			}
+		case key.Matches(msg, keys.Calendar.Enter):
+			if m.moving != nil {
+				task := *m.moving
+				m.moving = nil
+				if sameDay(task.DueDate, m.cursor) {
+					return m, nil
+				}
+				due := time.Date(m.cursor.Year(), m.cursor.Month(), m.cursor.Day(),
+					task.DueDate.Hour(), task.DueDate.Minute(), 0, 0, task.DueDate.Location())
+				return m, func() tea.Msg {
+					return RescheduleTaskMsg{TaskID: task.ID, DueDate: due}
+				}
+			}
+			if len(m.tasksOn(m.cursor)) > 0 {
+				m.listing = true
+				m.listCursor = 0
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m CalendarViewModel) updateList(msg tea.KeyMsg) (tea.Model, tea.Cmd) {
+	dayTasks := m.tasksOn(m.cursor)
+
+	switch {
+	case key.Matches(msg, keys.Calendar.Back):
+		m.listing = false
+	case key.Matches(msg, keys.Calendar.Up):
+		if m.listCursor > 0 {
+			m.listCursor--
+		}
+	case key.Matches(msg, keys.Calendar.Down):
+		if m.listCursor < len(dayTasks)-1 {
+			m.listCursor++
+		}
+	case key.Matches(msg, keys.Calendar.Move):
+		if m.listCursor < len(dayTasks) {
+			task := dayTasks[m.listCursor]
+			m.moving = &task
+			m.listing = false
+		}
+	case key.Matches(msg, keys.Calendar.Enter):
+		if m.listCursor < len(dayTasks) {
+			task := dayTasks[m.listCursor]
+			return m, func() tea.Msg {
+				return ShowDetailMsg{Task: task}
+			}
+		}
+	}
+	return m, nil
+}
+
+func (m *CalendarViewModel) UpdateTasks(tasks []models.Task) {
+	m.tasks = tasks
+	if n := len(m.tasksOn(m.cursor)); m.listCursor >= n {
+		m.listCursor = max(n-1, 0)
+		if n == 0 {
+			m.listing = false
+		}
+	}
+}
+
+func (m CalendarViewModel) ShouldReturn() bool {
+	return m.shouldReturn
+}
+
+// ResetReturn clears the return request so the calendar can be shown again
+func (m *CalendarViewModel) ResetReturn() {
+	m.shouldReturn = false
+}
+
+func (m CalendarViewModel) tasksOn(day time.Time) []models.Task {
+	var dayTasks []models.Task
+	for _, task := range m.tasks {
+		if sameDay(task.DueDate, day) {
+			dayTasks = append(dayTasks, task)
+		}
+	}
+	return dayTasks
+}
+
+func (m CalendarViewModel) View() string {
+	var content strings.Builder
+
+	header := m.cursor.Format("January 2006")
+	if m.mode == WeekMode {
+		start := startOfWeek(m.cursor)
+		header = fmt.Sprintf("Week of %s", start.Format("Jan 2, 2006"))
+	}
+	content.WriteString(titleStyle.Render(withIcon(glyphs.Calendar, header)))
+	content.WriteByte('\n')
+
+	var days []time.Time
+	var cellHeight int
+	if m.mode == WeekMode {
+		start := startOfWeek(m.cursor)
+		for i := 0; i < 7; i++ {
+			days = append(days, start.AddDate(0, 0, i))
+		}
+		cellHeight = max(m.height-10, 4)
+	} else {
+		first := time.Date(m.cursor.Year(), m.cursor.Month(), 1, 0, 0, 0, 0, m.cursor.Location())
+		start := startOfWeek(first)
+		last := first.AddDate(0, 1, -1)
+		for d := start; !d.After(last) || d.Weekday() != time.Sunday; d = d.AddDate(0, 0, 1) {
+			days = append(days, d)
+		}
+		weeks := len(days) / 7
+		cellHeight = max((m.height-10)/weeks-2, 2)
+	}
+
+	cellWidth := max((m.width-2)/7-2, 6)
+
+	weekdays := make([]string, 7)
+	for i := range weekdays {
+		weekdays[i] = calendarWeekdayStyle.Width(cellWidth + 2).Render(time.Weekday(i).String()[:3])
+	}
+	content.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, weekdays...))
+	content.WriteByte('\n')
+
+	rows := make([]string, 0, len(days)/7)
+	for w := 0; w < len(days); w += 7 {
+		cells := make([]string, 7)
+		for i, day := range days[w : w+7] {
+			cells[i] = m.renderDay(day, cellWidth, cellHeight)
+		}
+		rows = append(rows, lipgloss.JoinHorizontal(lipgloss.Top, cells...))
+	}
+	content.WriteString(lipgloss.JoinVertical(lipgloss.Left, rows...))
+	content.WriteByte('\n')
+
+	if m.listing {
+		content.WriteString(m.renderDayList())
+		content.WriteByte('\n')
+	}
+
+	hint := shortHint(keys.Calendar.ShortHelp())
+	if m.listing {
+		hint = shortHint([]key.Binding{
+			withDesc(keys.Calendar.Enter, "view details"), keys.Calendar.Move, withDesc(keys.Calendar.Back, "close"),
+		})
+	} else if m.moving != nil {
+		hint = fmt.Sprintf("Moving %q • pick a day • %s", m.moving.Title, shortHint([]key.Binding{
+			withDesc(keys.Calendar.Enter, "move here"), withDesc(keys.Calendar.Back, "cancel"),
+		}))
+	}
+	content.WriteString(statusStyle.Render(hint))
+
+	return baseStyle.
+		Width(m.width).
+		Height(m.height).
+		Render(content.String())
+}
+
+func (m CalendarViewModel) renderDay(day time.Time, width, height int) string {
+	var b strings.Builder
+
+	numberStyle := calendarDayNumberStyle
+	if sameDay(day, time.Now()) {
+		numberStyle = calendarTodayStyle
+	}
+	if m.mode == MonthMode && day.Month() != m.cursor.Month() {
+		numberStyle = calendarOutsideStyle
+	}
+	b.WriteString(numberStyle.Render(fmt.Sprintf("%2d", day.Day())))
+
+	dayTasks := m.tasksOn(day)
+	for i, task := range dayTasks {
+		if i == height-2 && len(dayTasks) > height-1 {
+			b.WriteString("\n" + calendarOutsideStyle.Render(fmt.Sprintf("+%d more", len(dayTasks)-i)))
+			break
+		}
+		b.WriteString("\n" + calendarTaskStyle.Render(truncate(calendarTaskLabel(task), width-2)))
+	}
+
+	style := calendarDayStyle
+	switch {
+	case m.moving != nil && sameDay(day, m.cursor):
+		style = calendarDropDayStyle
+	case sameDay(day, m.cursor):
+		style = calendarCursorDayStyle
+	}
+
+	return style.Width(width).Height(height).Render(b.String())
+}
+
+func (m CalendarViewModel) renderDayList() string {
+	var b strings.Builder
+	b.WriteString(labelStyle.Render(m.cursor.Format("Monday, January 2")))
+	for i, task := range m.tasksOn(m.cursor) {
+		line := fmt.Sprintf("%s %s", task.Priority.String(), task.Title)
+		if i == m.listCursor {
+			line = selectedOptionStyle.Render(line)
+		} else {
+			line = calendarTaskStyle.Render(line)
+		}
+		b.WriteString("\n" + line)
+	}
+	return calendarListStyle.Render(b.String())
+}
+
+// calendarTaskLabel colours overdue and high priority tasks with their priority colour
+func calendarTaskLabel(task models.Task) string {
+	if glyphs.Markers {
+		marker := priorityIcon(task.Priority)
+		if task.Completed {
+			marker = doneMarker
+		}
+		return marker + " " + task.Title
+	}
+	switch {
+	case task.Completed:
+		return calendarDoneStyle.Render(task.Title)
+	case task.Priority == models.HighestPriority() || task.DueBefore(time.Now()):
+		return lipgloss.NewStyle().
+			Foreground(theme.PriorityColor(task.Priority)).
+			Render(task.Title)
+	default:
+		return task.Title
+	}
+}
+
+func truncate(s string, width int) string {
+	return lipgloss.NewStyle().MaxWidth(width).Render(s)
+}
+
+func truncateDay(t time.Time) time.Time {
+	return time.Date(t.Year(), t.Month(), t.Day(), 0, 0, 0, 0, t.Location())
+}
+
+// dueDay is the local calendar day a task is due. Due dates are saved as
+// midnight UTC, so their date is read as it is rather than converted.
+func dueDay(due time.Time) time.Time {
+	return time.Date(due.Year(), due.Month(), due.Day(), 0, 0, 0, 0, time.Local)
+}
+
+func startOfWeek(t time.Time) time.Time {
+	return truncateDay(t).AddDate(0, 0, -int(t.Weekday()))
+}
+
+func sameDay(a, b time.Time) bool {
+	ay, am, ad := a.Date()
+	by, bm, bd := b.Date()
+	return ay == by && am == bm && ad == bd
+}
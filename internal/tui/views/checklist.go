@@ -0,0 +1,176 @@
+package views
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+// SetChecklistMsg replaces the checklist of a task
+type SetChecklistMsg struct {
+	TaskID string
+	Items  []models.ChecklistItem
+}
+
+// formatChecklist shows checklist progress such as "2/4", or nothing for
+// tasks without a checklist
+func formatChecklist(task models.Task) string {
+	checked, total := task.ChecklistProgress()
+	if total == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d/%d", checked, total)
+}
+
+// updateChecklist handles the checklist keys of the detail view. It reports
+// false for keys that are not checklist keys.
+func (m DetailViewModel) updateChecklist(msg tea.KeyMsg, task models.Task) (DetailViewModel, tea.Cmd, bool) {
+	items := task.Checklist
+	switch {
+	case key.Matches(msg, keys.Detail.NextItem):
+		m.item = max(min(m.item+1, len(items)-1), 0)
+	case key.Matches(msg, keys.Detail.PrevItem):
+		m.item = max(m.item-1, 0)
+	case key.Matches(msg, keys.Detail.AddItem):
+		m.openPrompt()
+		return m, textinput.Blink, true
+	case key.Matches(msg, keys.Detail.CheckItem):
+		if m.item < len(items) {
+			items = slices.Clone(items)
+			items[m.item].Checked = !items[m.item].Checked
+			return m, setChecklist(task.ID, items), true
+		}
+	case key.Matches(msg, keys.Detail.MoveUp), key.Matches(msg, keys.Detail.MoveDown):
+		to := m.item + 1
+		if key.Matches(msg, keys.Detail.MoveUp) {
+			to = m.item - 1
+		}
+		if m.item < len(items) && to >= 0 && to < len(items) {
+			items = slices.Clone(items)
+			items[m.item], items[to] = items[to], items[m.item]
+			m.item = to
+			return m, setChecklist(task.ID, items), true
+		}
+	case key.Matches(msg, keys.Detail.RemoveItem):
+		if m.item < len(items) {
+			items = slices.Delete(slices.Clone(items), m.item, m.item+1)
+			m.item = max(min(m.item, len(items)-1), 0)
+			return m, setChecklist(task.ID, items), true
+		}
+	default:
+		return m, nil, false
+	}
+	return m, nil, true
+}
+
+func setChecklist(id string, items []models.ChecklistItem) tea.Cmd {
+	return func() tea.Msg {
+		return SetChecklistMsg{TaskID: id, Items: items}
+	}
+}
+
+// openPrompt asks for the text of a new checklist item
+func (m *DetailViewModel) openPrompt() {
+	m.prompting = true
+	m.promptErr = ""
+	m.prompt = textinput.New()
+	m.prompt.Prompt = promptStyle.Render("Add item: ") + " "
+	m.prompt.Placeholder = "next step"
+	m.prompt.CharLimit = 120
+	m.prompt.Width = 40
+	m.prompt.Cursor.Style = cursorStyle
+	m.prompt.Focus()
+}
+
+// updatePrompt feeds a key to the item prompt and adds the item below the
+// selected one on submit
+func (m DetailViewModel) updatePrompt(msg tea.KeyMsg) (DetailViewModel, tea.Cmd) {
+	switch {
+	case key.Matches(msg, keys.Prompt.Cancel):
+		m.prompting = false
+		m.promptErr = ""
+		return m, nil
+	case key.Matches(msg, keys.Prompt.Submit):
+		text := strings.TrimSpace(m.prompt.Value())
+		if text == "" {
+			m.promptErr = "item must not be empty"
+			return m, nil
+		}
+		task, ok := m.Task()
+		if !ok {
+			m.prompting = false
+			return m, nil
+		}
+		at := 0
+		if len(task.Checklist) > 0 {
+			at = m.item + 1
+		}
+		items := slices.Insert(slices.Clone(task.Checklist), at, models.ChecklistItem{Text: text})
+		m.item = at
+		m.prompting = false
+		m.promptErr = ""
+		return m, setChecklist(task.ID, items)
+	}
+
+	var cmd tea.Cmd
+	m.prompt, cmd = m.prompt.Update(msg)
+	return m, cmd
+}
+
+// Capturing reports whether the item prompt is reading text and needs every key
+func (m DetailViewModel) Capturing() bool {
+	return m.prompting
+}
+
+// checklistLines renders the task's checklist, marking the selected item
+func checklistLines(task models.Task, selected int) []string {
+	var lines []string
+	for i, item := range task.Checklist {
+		box := pendingMarker
+		if item.Checked {
+			box = doneMarker
+		}
+		line := box + " " + item.Text
+		if i == selected {
+			if glyphs.Markers {
+				line = cursorMarker + " " + line
+			}
+			lines = append(lines, detailSelectedItemStyle.Render(line))
+			continue
+		}
+		if item.Checked {
+			lines = append(lines, detailTimeStyle.Render(line))
+			continue
+		}
+		lines = append(lines, detailValueStyle.Render(line))
+	}
+	return lines
+}
+
+// renderChecklist draws the checklist section of the detail view and the
+// preview pane, marking item selected if it is one. Items wrap at width when
+// it is positive.
+func renderChecklist(task models.Task, selected int, width int) string {
+	if len(task.Checklist) == 0 {
+		return ""
+	}
+	valueStyle := detailValueStyle.PaddingLeft(2)
+	if width > 0 {
+		valueStyle = valueStyle.Width(width)
+	}
+
+	var b strings.Builder
+	b.WriteString(detailLabelStyle.Render("Checklist " + formatChecklist(task)))
+	b.WriteString("\n")
+	for _, line := range checklistLines(task, selected) {
+		b.WriteString(valueStyle.Render(line))
+		b.WriteString("\n")
+	}
+	b.WriteString("\n")
+	return b.String()
+}
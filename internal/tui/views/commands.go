@@ -0,0 +1,261 @@
+package views
+
+import (
+	"slices"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+)
+
+// View names commands are scoped to. They match the root model's view names.
+const (
+	ContextGlobal   = "global"
+	ContextMain     = "main"
+	ContextForm     = "form"
+	ContextDetail   = "detail"
+	ContextBoard    = "board"
+	ContextCalendar = "calendar"
+	ContextAgenda   = "agenda"
+	ContextTrash    = "trash"
+	ContextArchive  = "archive"
+	ContextMatrix   = "matrix"
+	ContextTimeLog  = "time_log"
+	ContextEffort   = "effort"
+	ContextDeferred = "deferred"
+	ContextFocus    = "focus"
+)
+
+// Help sections commands are grouped under
+const (
+	SectionNavigation = "Navigation"
+	SectionTasks      = "Tasks"
+	SectionViews      = "Views"
+	SectionSelection  = "Selection"
+	SectionGeneral    = "General"
+)
+
+// Command is a user action reachable through a key binding in one or more views
+type Command struct {
+	Name     string
+	Section  string
+	Contexts []string
+	Binding  key.Binding
+}
+
+// RunCommandMsg asks the root model to run a command picked from the palette
+type RunCommandMsg struct {
+	Command Command
+}
+
+// Available reports whether the command can run in the given view
+func (c Command) Available(context string) bool {
+	return slices.Contains(c.Contexts, context) || slices.Contains(c.Contexts, ContextGlobal)
+}
+
+// KeyMsg builds the key press that triggers the command
+func (c Command) KeyMsg() tea.KeyMsg {
+	keys := c.Binding.Keys()
+	if len(keys) == 0 {
+		return tea.KeyMsg{}
+	}
+	return keyMsgFor(keys[0])
+}
+
+// Commands is the registry of actions the command palette and the help modal
+// list. Bindings are read from the live key maps, so remapped keys show up
+// in both.
+func Commands() []Command {
+	main := []string{ContextMain}
+	lists := []string{ContextMain, ContextBoard, ContextAgenda, ContextMatrix}
+	detail := []string{ContextDetail}
+	trash := []string{ContextTrash}
+	archive := []string{ContextArchive}
+	matrix := []string{ContextMatrix}
+	timeLog := []string{ContextTimeLog}
+	effort := []string{ContextEffort}
+	deferred := []string{ContextDeferred}
+	focus := []string{ContextFocus}
+
+	return []Command{
+		// Navigation
+		{"Move up", SectionNavigation, main, keys.Main.Up},
+		{"Move down", SectionNavigation, main, keys.Main.Down},
+		{"Page up", SectionNavigation, main, keys.Main.PageUp},
+		{"Page down", SectionNavigation, main, keys.Main.PageDown},
+		{"First task", SectionNavigation, main, keys.Main.Top},
+		{"Last task", SectionNavigation, main, keys.Main.Bottom},
+		{"View details", SectionNavigation, main, keys.Main.Enter},
+		{"Next field", SectionNavigation, []string{ContextForm}, keys.Form.Next},
+		{"Previous field", SectionNavigation, []string{ContextForm}, keys.Form.Prev},
+		{"Next field or save", SectionNavigation, []string{ContextForm}, keys.Form.Submit},
+		{"Previous column", SectionNavigation, []string{ContextBoard}, keys.Board.Left},
+		{"Next column", SectionNavigation, []string{ContextBoard}, keys.Board.Right},
+		{"Card up", SectionNavigation, []string{ContextBoard}, keys.Board.Up},
+		{"Card down", SectionNavigation, []string{ContextBoard}, keys.Board.Down},
+		{"Open card", SectionNavigation, []string{ContextBoard}, keys.Board.Enter},
+		{"Previous day", SectionNavigation, []string{ContextCalendar}, keys.Calendar.Left},
+		{"Next day", SectionNavigation, []string{ContextCalendar}, keys.Calendar.Right},
+		{"Previous week", SectionNavigation, []string{ContextCalendar}, keys.Calendar.Up},
+		{"Next week", SectionNavigation, []string{ContextCalendar}, keys.Calendar.Down},
+		{"Previous month", SectionNavigation, []string{ContextCalendar}, keys.Calendar.PrevMonth},
+		{"Next month", SectionNavigation, []string{ContextCalendar}, keys.Calendar.NextMonth},
+		{"Jump to today", SectionNavigation, []string{ContextCalendar}, keys.Calendar.Today},
+		{"List day", SectionNavigation, []string{ContextCalendar}, keys.Calendar.Enter},
+		{"Agenda up", SectionNavigation, []string{ContextAgenda}, keys.Agenda.Up},
+		{"Agenda down", SectionNavigation, []string{ContextAgenda}, keys.Agenda.Down},
+		{"Open agenda task", SectionNavigation, []string{ContextAgenda}, keys.Agenda.Enter},
+		{"Scroll details up", SectionNavigation, detail, keys.Detail.Up},
+		{"Scroll details down", SectionNavigation, detail, keys.Detail.Down},
+		{"Details page up", SectionNavigation, detail, keys.Detail.PageUp},
+		{"Details page down", SectionNavigation, detail, keys.Detail.PageDown},
+		{"Next task", SectionNavigation, detail, keys.Detail.Next},
+		{"Previous task", SectionNavigation, detail, keys.Detail.Prev},
+		{"Trash up", SectionNavigation, trash, keys.Trash.Up},
+		{"Trash down", SectionNavigation, trash, keys.Trash.Down},
+		{"Matrix up", SectionNavigation, matrix, keys.Matrix.Up},
+		{"Matrix down", SectionNavigation, matrix, keys.Matrix.Down},
+		{"Urgent quadrant", SectionNavigation, matrix, keys.Matrix.Left},
+		{"Not urgent quadrant", SectionNavigation, matrix, keys.Matrix.Right},
+		{"Open matrix task", SectionNavigation, matrix, keys.Matrix.Enter},
+		{"Archive up", SectionNavigation, archive, keys.Archive.Up},
+		{"Archive down", SectionNavigation, archive, keys.Archive.Down},
+		{"Search archive", SectionNavigation, archive, keys.Archive.Search},
+		{"Previous match", SectionNavigation, archive, keys.Archive.SearchUp},
+		{"Next match", SectionNavigation, archive, keys.Archive.SearchDown},
+		{"Time entry up", SectionNavigation, timeLog, keys.TimeLog.Up},
+		{"Time entry down", SectionNavigation, timeLog, keys.TimeLog.Down},
+		{"Report up", SectionNavigation, effort, keys.Effort.Up},
+		{"Report down", SectionNavigation, effort, keys.Effort.Down},
+		{"Open report task", SectionNavigation, effort, keys.Effort.Enter},
+		{"Deferred up", SectionNavigation, deferred, keys.Deferred.Up},
+		{"Deferred down", SectionNavigation, deferred, keys.Deferred.Down},
+		{"Open deferred task", SectionNavigation, deferred, keys.Deferred.Enter},
+
+		// Tasks
+		{"New task", SectionTasks, lists, keys.Main.New},
+		{"Edit task", SectionTasks, main, keys.Main.Edit},
+		{"Next status", SectionTasks, main, keys.Main.Space},
+		{"Complete task", SectionTasks, main, keys.Main.Complete},
+		{"Delete task", SectionTasks, main, keys.Main.Delete},
+		{"Advance agenda task", SectionTasks, []string{ContextAgenda}, keys.Agenda.Space},
+		{"Move card left", SectionTasks, []string{ContextBoard}, keys.Board.MoveLeft},
+		{"Move card right", SectionTasks, []string{ContextBoard}, keys.Board.MoveRight},
+		{"Move task to another day", SectionTasks, []string{ContextCalendar}, keys.Calendar.Move},
+		{"Lower priority", SectionTasks, []string{ContextForm}, keys.Form.PriorityLeft},
+		{"Higher priority", SectionTasks, []string{ContextForm}, keys.Form.PriorityRight},
+		{"Edit task", SectionTasks, detail, keys.Detail.Edit},
+		{"Toggle completed", SectionTasks, detail, keys.Detail.Toggle},
+		{"Next status", SectionTasks, detail, keys.Detail.Advance},
+		{"Delete task", SectionTasks, detail, keys.Detail.Delete},
+		{"Copy task ID", SectionTasks, detail, keys.Detail.CopyID},
+		{"Copy task title", SectionTasks, detail, keys.Detail.CopyTitle},
+		{"Next checklist item", SectionTasks, detail, keys.Detail.NextItem},
+		{"Previous checklist item", SectionTasks, detail, keys.Detail.PrevItem},
+		{"Check/uncheck item", SectionTasks, detail, keys.Detail.CheckItem},
+		{"Add checklist item", SectionTasks, detail, keys.Detail.AddItem},
+		{"Move item up", SectionTasks, detail, keys.Detail.MoveUp},
+		{"Move item down", SectionTasks, detail, keys.Detail.MoveDown},
+		{"Remove checklist item", SectionTasks, detail, keys.Detail.RemoveItem},
+		{"Restore task", SectionTasks, trash, keys.Trash.Restore},
+		{"Delete task forever", SectionTasks, trash, keys.Trash.Purge},
+		{"Empty trash", SectionTasks, trash, keys.Trash.Empty},
+		{"Advance matrix task", SectionTasks, matrix, keys.Matrix.Space},
+		{"Archive task", SectionTasks, main, keys.Main.ArchiveTask},
+		{"Unarchive task", SectionTasks, archive, keys.Archive.Unarchive},
+		{"Start/stop timer", SectionTasks, main, keys.Main.Timer},
+		{"Start/stop timer", SectionTasks, detail, keys.Detail.Timer},
+		{"Start/stop timer", SectionTasks, timeLog, keys.TimeLog.Timer},
+		{"Log time", SectionTasks, timeLog, keys.TimeLog.Add},
+		{"Edit time entry", SectionTasks, timeLog, keys.TimeLog.Edit},
+		{"Delete time entry", SectionTasks, timeLog, keys.TimeLog.Delete},
+		{"Pause/resume pomodoro", SectionTasks, focus, keys.Focus.Pause},
+		{"Skip pomodoro phase", SectionTasks, focus, keys.Focus.Skip},
+		{"Mark focus task done", SectionTasks, focus, keys.Focus.Done},
+		{"Show task now", SectionTasks, deferred, keys.Deferred.Wake},
+
+		// Selection
+		{"Visual select", SectionSelection, main, keys.Main.Visual},
+		{"Extend selection up", SectionSelection, main, keys.Main.SelectUp},
+		{"Extend selection down", SectionSelection, main, keys.Main.SelectDown},
+		{"Mark/unmark task", SectionSelection, main, keys.Main.Mark},
+		{"Select all", SectionSelection, main, keys.Main.SelectAll},
+		{"Clear selection", SectionSelection, main, keys.Main.Clear},
+		{"Set priority", SectionSelection, main, keys.Main.Priority},
+		{"Add tag", SectionSelection, main, keys.Main.Tag},
+		{"Reschedule", SectionSelection, main, keys.Main.Reschedule},
+		{"Snooze", SectionSelection, main, keys.Main.Snooze},
+		{"Undo bulk action", SectionSelection, main, keys.Main.Undo},
+
+		// Views
+		{"Board view", SectionViews, main, keys.Main.Board},
+		{"Calendar view", SectionViews, main, keys.Main.Calendar},
+		{"Today agenda", SectionViews, main, keys.Main.Agenda},
+		{"Eisenhower matrix", SectionViews, main, keys.Main.Matrix},
+		{"Estimates report", SectionViews, main, keys.Main.Effort},
+		{"Deferred tasks", SectionViews, main, keys.Main.Deferred},
+		{"Trash", SectionViews, main, keys.Main.Trash},
+		{"Archive", SectionViews, main, keys.Main.Archive},
+		{"Toggle preview pane", SectionViews, main, keys.Main.Preview},
+		{"Widen preview pane", SectionViews, main, keys.Main.PreviewGrow},
+		{"Narrow preview pane", SectionViews, main, keys.Main.PreviewShrink},
+		{"Back to table", SectionViews, []string{ContextBoard}, keys.Board.Back},
+		{"Back to table", SectionViews, []string{ContextCalendar}, keys.Calendar.Back},
+		{"Back to table", SectionViews, []string{ContextAgenda}, keys.Agenda.Table},
+		{"Month/week layout", SectionViews, []string{ContextCalendar}, keys.Calendar.Mode},
+		{"Back", SectionViews, detail, keys.Detail.Back},
+		{"Back to table", SectionViews, trash, keys.Trash.Back},
+		{"Back to table", SectionViews, archive, keys.Archive.Back},
+		{"Back to table", SectionViews, matrix, keys.Matrix.Back},
+		{"Time log", SectionViews, detail, keys.Detail.TimeLog},
+		{"Focus mode", SectionViews, main, keys.Main.Focus},
+		{"Focus mode", SectionViews, detail, keys.Detail.Focus},
+		{"Stop focus", SectionViews, focus, keys.Focus.Back},
+		{"Back to details", SectionViews, timeLog, keys.TimeLog.Back},
+		{"Back to table", SectionViews, effort, keys.Effort.Back},
+		{"Back to table", SectionViews, deferred, keys.Deferred.Back},
+		{"Cancel", SectionViews, []string{ContextForm}, keys.Form.Cancel},
+
+		// General
+		{"Keyboard help", SectionGeneral, []string{ContextGlobal}, keys.Global.Help},
+		{"Next theme", SectionGeneral, []string{ContextGlobal}, keys.Global.Theme},
+		{"Quit from table", SectionGeneral, main, keys.Main.Quit},
+		{"Command palette", SectionGeneral, []string{ContextGlobal}, keys.Global.Palette},
+		{"Quit", SectionGeneral, []string{ContextGlobal}, keys.Global.Quit},
+	}
+}
+
+// CommandsFor returns the registered commands available in a view
+func CommandsFor(context string) []Command {
+	var available []Command
+	for _, c := range Commands() {
+		if c.Available(context) && c.Binding.Enabled() {
+			available = append(available, c)
+		}
+	}
+	return available
+}
+
+// keyNames maps key strings such as "enter" or "ctrl+a" back to their key type
+var keyNames = func() map[string]tea.KeyType {
+	names := make(map[string]tea.KeyType)
+	for k := tea.KeyType(-200); k < 200; k++ {
+		if name := k.String(); name != "" {
+			names[name] = k
+		}
+	}
+	return names
+}()
+
+// keyMsgFor converts a binding key string into the key press it describes
+func keyMsgFor(s string) tea.KeyMsg {
+	if rest, ok := strings.CutPrefix(s, "alt+"); ok && rest != "" {
+		msg := keyMsgFor(rest)
+		msg.Alt = true
+		return msg
+	}
+	if k, ok := keyNames[s]; ok {
+		return tea.KeyMsg{Type: k}
+	}
+	return tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune(s)}
+}
@@ -0,0 +1,62 @@
+package views
+
+import (
+	"slices"
+	"testing"
+
+	"github.com/charmbracelet/bubbles/help"
+)
+
+// keyMaps are the views' key maps, by the context their commands run in
+func keyMaps() map[string]help.KeyMap {
+	return map[string]help.KeyMap{
+		ContextMain:     keys.Main,
+		ContextForm:     keys.Form,
+		ContextDetail:   keys.Detail,
+		ContextBoard:    keys.Board,
+		ContextCalendar: keys.Calendar,
+		ContextAgenda:   keys.Agenda,
+		ContextTrash:    keys.Trash,
+		ContextArchive:  keys.Archive,
+		ContextMatrix:   keys.Matrix,
+		ContextTimeLog:  keys.TimeLog,
+		ContextEffort:   keys.Effort,
+		ContextDeferred: keys.Deferred,
+		ContextFocus:    keys.Focus,
+	}
+}
+
+// TestCommandsCoverKeyMaps checks that every binding a view's key map lists
+// is registered as a command, so the palette and the help modal show it
+func TestCommandsCoverKeyMaps(t *testing.T) {
+	for context, km := range keyMaps() {
+		var commands []HelpItem
+		for _, c := range Commands() {
+			if slices.Contains(c.Contexts, context) && c.Binding.Enabled() {
+				commands = append(commands, HelpItem{c.Binding.Help().Key, c.Binding.Help().Desc})
+			}
+		}
+		for _, group := range km.FullHelp() {
+			for _, b := range group {
+				item := HelpItem{b.Help().Key, b.Help().Desc}
+				if b.Enabled() && !slices.Contains(commands, item) {
+					t.Errorf("%s: %q %q has no command", context, item.Key, item.Description)
+				}
+			}
+		}
+	}
+}
+
+func TestHelpListsTheViewsCommands(t *testing.T) {
+	for context := range keyMaps() {
+		var listed []HelpItem
+		for _, section := range helpSections(context) {
+			listed = append(listed, section.Items...)
+		}
+		for _, c := range CommandsFor(context) {
+			if item := (HelpItem{c.Binding.Help().Key, c.Name}); !slices.Contains(listed, item) {
+				t.Errorf("%s: help is missing %q %q", context, item.Key, item.Description)
+			}
+		}
+	}
+}
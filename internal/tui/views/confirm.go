@@ -0,0 +1,173 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sabry-awad97/task-manager/internal/tui/zone"
+)
+
+var (
+	confirmStyle       lipgloss.Style
+	confirmTitleStyle  lipgloss.Style
+	confirmPromptStyle lipgloss.Style
+	confirmHintStyle   lipgloss.Style
+)
+
+// setConfirmStyles derives the confirmation modal's styles from t
+func setConfirmStyles(t Theme) {
+	confirmStyle = lipgloss.NewStyle().
+		Border(glyphs.Border).
+		BorderForeground(t.Warning).
+		Padding(1, 2)
+
+	confirmTitleStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Warning).
+		MarginBottom(1)
+
+	confirmPromptStyle = lipgloss.NewStyle().
+		Foreground(t.Text).
+		Width(confirmWidth)
+
+	confirmHintStyle = lipgloss.NewStyle().
+		Foreground(t.Muted).
+		MarginTop(1)
+}
+
+type confirmKeyMap struct {
+	Yes    key.Binding
+	No     key.Binding
+	Switch key.Binding
+	Submit key.Binding
+}
+
+// confirmWidth is the width the prompt wraps at
+const confirmWidth = 44
+
+// Clickable buttons of the modal
+const (
+	confirmYesZone = "confirm.yes"
+	confirmNoZone  = "confirm.no"
+)
+
+// ConfirmMsg asks the root model to confirm an action. Confirm is sent
+// once the user agrees; nothing is sent when they decline.
+type ConfirmMsg struct {
+	Title   string
+	Prompt  string
+	Confirm tea.Msg
+}
+
+// ConfirmModel is a yes/no modal. No has the focus when it opens so a
+// stray enter never confirms.
+type ConfirmModel struct {
+	title   string
+	prompt  string
+	confirm tea.Msg
+	yes     bool // The yes button has the focus
+	width   int
+	height  int
+	closed  bool
+}
+
+func NewConfirmModel(msg ConfirmMsg, width, height int) ConfirmModel {
+	return ConfirmModel{
+		title:   msg.Title,
+		prompt:  msg.Prompt,
+		confirm: msg.Confirm,
+		width:   width,
+		height:  height,
+	}
+}
+
+func (m ConfirmModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m ConfirmModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Confirm.Yes):
+			return m.accept()
+		case key.Matches(msg, keys.Confirm.No):
+			m.closed = true
+		case key.Matches(msg, keys.Confirm.Switch):
+			m.yes = !m.yes
+		case key.Matches(msg, keys.Confirm.Submit):
+			if m.yes {
+				return m.accept()
+			}
+			m.closed = true
+		}
+
+	case tea.MouseMsg:
+		if msg.Action != tea.MouseActionPress || msg.Button != tea.MouseButtonLeft {
+			break
+		}
+		switch {
+		case zone.Get(confirmYesZone).InBounds(msg):
+			return m.accept()
+		case zone.Get(confirmNoZone).InBounds(msg):
+			m.closed = true
+		}
+	}
+	return m, nil
+}
+
+// accept closes the modal and sends the confirmed message
+func (m ConfirmModel) accept() (tea.Model, tea.Cmd) {
+	m.closed = true
+	confirm := m.confirm
+	return m, func() tea.Msg { return confirm }
+}
+
+// Closed reports whether the user answered
+func (m ConfirmModel) Closed() bool {
+	return m.closed
+}
+
+func (m ConfirmModel) View() string {
+	var content strings.Builder
+	content.WriteString(confirmTitleStyle.Render(m.title))
+	content.WriteByte('\n')
+	content.WriteString(confirmPromptStyle.Render(m.prompt))
+	content.WriteString("\n\n")
+
+	buttons := lipgloss.JoinHorizontal(lipgloss.Top,
+		zone.Mark(confirmYesZone, renderConfirmButton("Yes", m.yes)),
+		"  ",
+		zone.Mark(confirmNoZone, renderConfirmButton("No", !m.yes)),
+	)
+	content.WriteString(lipgloss.PlaceHorizontal(confirmWidth, lipgloss.Center, buttons))
+	content.WriteByte('\n')
+	content.WriteString(confirmHintStyle.Render(shortHint([]key.Binding{
+		keys.Confirm.Yes, keys.Confirm.No, keys.Confirm.Switch,
+	})))
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		confirmStyle.Render(content.String()),
+	)
+}
+
+// renderConfirmButton draws a button, marking the focused one when colour is not enough
+func renderConfirmButton(label string, focused bool) string {
+	if !focused {
+		return buttonStyle.Render(label)
+	}
+	if glyphs.Markers {
+		label = cursorMarker + " " + label
+	}
+	return activeButtonStyle.Render(label)
+}
@@ -0,0 +1,185 @@
+package views
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+	"github.com/sabry-awad97/task-manager/internal/tui/zone"
+)
+
+type deferredKeyMap struct {
+	Up    key.Binding
+	Down  key.Binding
+	Enter key.Binding
+	Wake  key.Binding
+	Back  key.Binding
+}
+
+func (k deferredKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Enter, k.Wake, k.Back}
+}
+
+func (k deferredKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Enter},
+		{k.Wake},
+		{k.Back},
+	}
+}
+
+// DeferredViewModel lists the snoozed tasks the other views hide until their time comes
+type DeferredViewModel struct {
+	tasks        []models.Task // Deferred tasks, the soonest to show first
+	cursor       int
+	width        int
+	height       int
+	shouldReturn bool
+}
+
+func NewDeferredViewModel() DeferredViewModel {
+	return DeferredViewModel{}
+}
+
+func (m DeferredViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m DeferredViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Deferred.Back):
+			m.shouldReturn = true
+		case key.Matches(msg, keys.Deferred.Up):
+			m.cursor = max(m.cursor-1, 0)
+		case key.Matches(msg, keys.Deferred.Down):
+			m.cursor = max(min(m.cursor+1, len(m.tasks)-1), 0)
+		case key.Matches(msg, keys.Deferred.Enter):
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return ShowDetailMsg{Task: task}
+				}
+			}
+		case key.Matches(msg, keys.Deferred.Wake):
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return BulkActionMsg{Action: BulkSnooze, TaskIDs: []string{task.ID}}
+				}
+			}
+		}
+
+	case tea.MouseMsg:
+		if msg.Action != tea.MouseActionPress {
+			break
+		}
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.cursor = max(m.cursor-wheelStep, 0)
+		case tea.MouseButtonWheelDown:
+			m.cursor = max(min(m.cursor+wheelStep, len(m.tasks)-1), 0)
+		case tea.MouseButtonLeft:
+			start, end := m.window()
+			for i := start; i < end; i++ {
+				if zone.Get(deferredRowZone(i)).InBounds(msg) {
+					m.cursor = i
+				}
+			}
+		}
+	}
+	return m, nil
+}
+
+// UpdateTasks replaces the deferred tasks, ordered by when they show again
+func (m *DeferredViewModel) UpdateTasks(tasks []models.Task) {
+	m.tasks = slices.Clone(tasks)
+	slices.SortStableFunc(m.tasks, func(a, b models.Task) int {
+		return a.DeferUntil.Compare(*b.DeferUntil)
+	})
+	m.cursor = max(min(m.cursor, len(m.tasks)-1), 0)
+}
+
+func (m DeferredViewModel) SelectedTask() (models.Task, bool) {
+	if m.cursor < 0 || m.cursor >= len(m.tasks) {
+		return models.Task{}, false
+	}
+	return m.tasks[m.cursor], true
+}
+
+func (m DeferredViewModel) ShouldReturn() bool {
+	return m.shouldReturn
+}
+
+// ResetReturn clears the return request so the list can be shown again
+func (m *DeferredViewModel) ResetReturn() {
+	m.shouldReturn = false
+}
+
+func deferredRowZone(i int) string {
+	return fmt.Sprintf("deferred.row.%d", i)
+}
+
+// window returns the range of tasks that fit on screen around the cursor
+func (m DeferredViewModel) window() (int, int) {
+	// Leave room for the frame, the title, the summary and the status line
+	height := len(m.tasks)
+	if m.height > 0 {
+		height = max(m.height-9, 1)
+	}
+	start := max(m.cursor-height+1, 0)
+	return start, min(start+height, len(m.tasks))
+}
+
+func (m DeferredViewModel) View() string {
+	var content strings.Builder
+
+	content.WriteString(titleStyle.Render(withIcon(glyphs.Deferred, "Deferred")))
+	content.WriteByte('\n')
+	content.WriteString(trashAgeStyle.Render(fmt.Sprintf("%d tasks hidden until a later time", len(m.tasks))))
+	content.WriteString("\n\n")
+
+	if len(m.tasks) == 0 {
+		content.WriteString(trashEmptyStyle.Render("No task is deferred"))
+		content.WriteByte('\n')
+	}
+
+	now := time.Now()
+	start, end := m.window()
+	for i := start; i < end; i++ {
+		content.WriteString(zone.Mark(deferredRowZone(i), m.renderItem(m.tasks[i], i == m.cursor, now)))
+		content.WriteByte('\n')
+	}
+
+	content.WriteByte('\n')
+	content.WriteString(statusStyle.Render(shortHint(keys.Deferred.ShortHelp())))
+
+	return baseStyle.
+		Width(m.width - 2).
+		Height(m.height - 2).
+		Render(content.String())
+}
+
+func (m DeferredViewModel) renderItem(task models.Task, selected bool, now time.Time) string {
+	until := task.DeferUntil.Local()
+	when := "until " + until.Format("Mon Jan 2 15:04") + ", " + relativeDue(until, now)
+	if !task.DueDate.IsZero() {
+		when += " " + glyphs.ActionSeparator + " due " + task.DueDate.Format("Jan 2")
+	}
+
+	if selected {
+		line := task.Title + "  " + when
+		if glyphs.Markers {
+			line = cursorMarker + " " + line
+		}
+		return trashSelectedItemStyle.Render(line)
+	}
+	return trashItemStyle.Render(task.Title + "  " + trashAgeStyle.Render(when))
+}
@@ -2,51 +2,89 @@ package views
 
 import (
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
+	"github.com/atotto/clipboard"
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/sabry-awad97/task-manager/internal/tui/models"
 )
 
 var (
+	detailContainerStyle    lipgloss.Style
+	detailHeaderStyle       lipgloss.Style
+	detailLabelStyle        lipgloss.Style
+	detailValueStyle        lipgloss.Style
+	detailTimeStyle         lipgloss.Style
+	detailFooterStyle       lipgloss.Style
+	detailSelectedItemStyle lipgloss.Style
+)
+
+// setDetailStyles derives the detail view's styles from t
+func setDetailStyles(t Theme) {
 	detailContainerStyle = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(lipgloss.Color("205")).
-				Padding(1, 2)
+		Border(glyphs.Border).
+		BorderForeground(t.Accent).
+		Padding(1, 2)
 
 	detailHeaderStyle = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(lipgloss.Color("205")).
-				MarginBottom(1)
+		Bold(true).
+		Foreground(t.Accent).
+		MarginBottom(1)
 
 	detailLabelStyle = lipgloss.NewStyle().
-				Bold(true).
-				Foreground(lipgloss.Color("99"))
+		Bold(true).
+		Foreground(t.Secondary)
 
 	detailValueStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("255"))
+		Foreground(t.Text)
 
 	detailTimeStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")).
-			Italic(true)
+		Foreground(t.Muted).
+		Italic(true)
 
 	detailFooterStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("241")).
-				Align(lipgloss.Center).
-				MarginTop(1)
-)
+		Foreground(t.Muted).
+		Align(lipgloss.Center).
+		MarginTop(1)
+
+	detailSelectedItemStyle = lipgloss.NewStyle().
+		Foreground(t.Accent).
+		Bold(true)
+}
 
 type DetailViewModel struct {
-	task         models.Task
+	tasks        []models.Task // Next and previous step through these, in list order
+	index        int
+	offset       int    // First content line on screen
+	status       string // Outcome of the last copy
+	item         int    // Selected checklist item
+	prompt       textinput.Model
+	prompting    bool // Reading a new checklist item
+	promptErr    string
 	width        int
 	height       int
 	shouldReturn bool
 }
 
-func NewDetailViewModel(task models.Task) DetailViewModel {
-	return DetailViewModel{task: task}
+// detailMaxWidth caps the detail frame on wide terminals
+const detailMaxWidth = 80
+
+// copiedMsg reports the outcome of copying a task field to the clipboard
+type copiedMsg struct {
+	what string
+	err  error
+}
+
+// NewDetailViewModel shows task, stepping through tasks for next and previous
+func NewDetailViewModel(task models.Task, tasks []models.Task) DetailViewModel {
+	m := DetailViewModel{tasks: []models.Task{task}}
+	m.UpdateTasks(tasks)
+	return m
 }
 
 func (m DetailViewModel) Init() tea.Cmd {
@@ -58,48 +96,264 @@ func (m DetailViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
+		m.scroll(0)
+
+	case copiedMsg:
+		m.status = "Copied " + msg.what
+		if msg.err != nil {
+			m.status = "Could not copy " + msg.what + ": " + msg.err.Error()
+		}
+
+	case tea.MouseMsg:
+		if msg.Action == tea.MouseActionPress {
+			switch msg.Button {
+			case tea.MouseButtonWheelUp:
+				m.scroll(-wheelStep)
+			case tea.MouseButtonWheelDown:
+				m.scroll(wheelStep)
+			}
+		}
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "esc", "q":
+		if m.prompting {
+			return m.updatePrompt(msg)
+		}
+		m.status = ""
+		task, ok := m.Task()
+		if ok {
+			if m, cmd, handled := m.updateChecklist(msg, task); handled {
+				return m, cmd
+			}
+		}
+		switch {
+		case key.Matches(msg, keys.Detail.Back):
 			m.shouldReturn = true
+		case key.Matches(msg, keys.Detail.Up):
+			m.scroll(-1)
+		case key.Matches(msg, keys.Detail.Down):
+			m.scroll(1)
+		case key.Matches(msg, keys.Detail.PageUp):
+			m.scroll(-m.bodyHeight())
+		case key.Matches(msg, keys.Detail.PageDown):
+			m.scroll(m.bodyHeight())
+		case key.Matches(msg, keys.Detail.Next):
+			m.step(1)
+		case key.Matches(msg, keys.Detail.Prev):
+			m.step(-1)
+		case !ok:
+		case key.Matches(msg, keys.Detail.Edit):
+			return m, func() tea.Msg {
+				return EditTaskMsg{Task: task}
+			}
+		case key.Matches(msg, keys.Detail.Toggle):
+			return m, func() tea.Msg {
+				return ToggleTaskMsg{TaskID: task.ID}
+			}
+		case key.Matches(msg, keys.Detail.Advance):
+			return m, func() tea.Msg {
+				return AdvanceTaskMsg{TaskID: task.ID}
+			}
+		case key.Matches(msg, keys.Detail.Delete):
+			return m, func() tea.Msg {
+				return DeleteTaskMsg{TaskID: task.ID}
+			}
+		case key.Matches(msg, keys.Detail.CopyID):
+			return m, copyToClipboard("ID", task.ID)
+		case key.Matches(msg, keys.Detail.CopyTitle):
+			return m, copyToClipboard("title", task.Title)
+		case key.Matches(msg, keys.Detail.Timer):
+			return m, func() tea.Msg {
+				return ToggleTimerMsg{TaskID: task.ID}
+			}
+		case key.Matches(msg, keys.Detail.TimeLog):
+			return m, func() tea.Msg {
+				return ShowTimeLogMsg{Task: task}
+			}
+		case key.Matches(msg, keys.Detail.Focus):
+			return m, func() tea.Msg {
+				return ShowFocusMsg{Task: task}
+			}
+		}
+
+	default:
+		// Cursor blink for the prompt
+		if m.prompting {
+			var cmd tea.Cmd
+			m.prompt, cmd = m.prompt.Update(msg)
+			return m, cmd
 		}
 	}
 	return m, nil
 }
 
-func (m DetailViewModel) View() string {
+// copyToClipboard writes text to the system clipboard in the background
+func copyToClipboard(what, text string) tea.Cmd {
+	return func() tea.Msg {
+		return copiedMsg{what: what, err: clipboard.WriteAll(text)}
+	}
+}
+
+// Task returns the task on screen
+func (m DetailViewModel) Task() (models.Task, bool) {
+	if m.index < 0 || m.index >= len(m.tasks) {
+		return models.Task{}, false
+	}
+	return m.tasks[m.index], true
+}
+
+// UpdateTasks refreshes the tasks, following the shown task by ID. When it
+// is gone the task that took its place is shown; with no tasks left the
+// view asks to return.
+func (m *DetailViewModel) UpdateTasks(tasks []models.Task) {
+	current, ok := m.Task()
+	m.tasks = tasks
+	if ok {
+		if i := slices.IndexFunc(tasks, func(t models.Task) bool { return t.ID == current.ID }); i >= 0 {
+			m.index = i
+			m.item = max(min(m.item, len(tasks[i].Checklist)-1), 0)
+			return
+		}
+	}
+	m.index = max(min(m.index, len(tasks)-1), 0)
+	m.offset = 0
+	m.item = 0
+	m.prompting = false
+	if len(tasks) == 0 {
+		m.shouldReturn = true
+	}
+}
+
+// step moves to the task delta places away in the list
+func (m *DetailViewModel) step(delta int) {
+	index := max(min(m.index+delta, len(m.tasks)-1), 0)
+	if index != m.index {
+		m.index = index
+		m.offset = 0
+		m.item = 0
+	}
+}
+
+// scroll moves the content by delta lines, keeping the last page full
+func (m *DetailViewModel) scroll(delta int) {
+	height := m.bodyHeight()
+	if height <= 0 {
+		m.offset = 0
+		return
+	}
+	m.offset = max(min(m.offset+delta, len(m.bodyLines())-height), 0)
+}
+
+// frameWidth is the detail frame width, padding included
+func (m DetailViewModel) frameWidth() int {
+	return min(m.width-2, detailMaxWidth)
+}
+
+// bodyHeight is how many content lines fit above the footer, or 0 before
+// the first window size
+func (m DetailViewModel) bodyHeight() int {
+	if m.height == 0 {
+		return 0
+	}
+	frame := detailContainerStyle.GetVerticalFrameSize()
+	return max(m.height-frame-lipgloss.Height(m.footer()), 1)
+}
+
+// bodyLines renders the header and fields of the shown task
+func (m DetailViewModel) bodyLines() []string {
 	var content strings.Builder
 
 	// Header with task title
-	content.WriteString(detailHeaderStyle.Render("📝 Task Details"))
+	content.WriteString(detailHeaderStyle.Render(withIcon(glyphs.Detail, "Task Details")))
 	content.WriteString("\n\n")
 
-	// Format task details
-	details := []struct {
-		label string
-		value string
-	}{
-		{"Title", m.task.Title},
-		{"Description", m.task.Description},
-		{"Priority", getPriorityWithIcon(m.task.Priority)},
-		{"Status", getStatusWithIcon(m.task.Completed)},
-		{"Due Date", formatDate(m.task.DueDate)},
-		{"Created", formatDate(m.task.CreatedAt)},
+	if task, ok := m.Task(); ok {
+		width := 0
+		if m.width > 0 {
+			width = m.frameWidth() - detailContainerStyle.GetHorizontalPadding()
+		}
+		content.WriteString(renderDetails(taskDetails(task), width))
+		content.WriteString(renderChecklist(task, m.item, width))
+		content.WriteString(renderTimeTotals(task, m.tasks, width))
+		content.WriteString(renderActivity(task, width))
 	}
+	return strings.Split(strings.TrimRight(content.String(), "\n"), "\n")
+}
 
-	// Render details
-	for _, detail := range details {
-		if detail.value != "" {
-			content.WriteString(detailLabelStyle.Render(detail.label))
-			content.WriteString("\n")
-			content.WriteString(detailValueStyle.Render(detail.value))
-			content.WriteString("\n\n")
+// footer shows the position in the list, the last copy and the key hints
+func (m DetailViewModel) footer() string {
+	position := fmt.Sprintf("Task %d of %d", m.index+1, len(m.tasks))
+	if m.status != "" {
+		position += " " + glyphs.ActionSeparator + " " + m.status
+	}
+	style := detailFooterStyle
+	if m.width > 0 {
+		style = style.Width(m.frameWidth() - detailContainerStyle.GetHorizontalPadding())
+	}
+	hint := shortHint(keys.Detail.ShortHelp())
+	if m.prompting {
+		hint = m.prompt.View()
+		if m.promptErr != "" {
+			hint += "  " + errorStyle.Render(m.promptErr)
 		}
 	}
+	return style.Render(position + "\n" + hint)
+}
+
+func (m DetailViewModel) View() string {
+	// Screen readers get one "label: value" line per field, without the frame
+	if display.ScreenReader {
+		var lines []string
+		if task, ok := m.Task(); ok {
+			for _, detail := range taskDetails(task) {
+				if detail.value != "" {
+					lines = append(lines, detail.label+": "+detail.value)
+				}
+			}
+			for i, item := range task.Checklist {
+				line := fmt.Sprintf("Checklist item %d: %s", i+1, item.Text)
+				if item.Checked {
+					line += " (checked)"
+				}
+				if i == m.item {
+					line += " (selected)"
+				}
+				lines = append(lines, line)
+			}
+			for _, total := range timeTotals(task, m.tasks, time.Now()) {
+				lines = append(lines, "Time "+total.label+": "+total.value)
+			}
+			for _, entry := range activity(task) {
+				line := "Activity: " + entry.when
+				if entry.by != "" {
+					line += " by " + entry.by
+				}
+				lines = append(lines, line+": "+strings.Join(entry.what, "; "))
+			}
+		}
+		lines = append(lines, fmt.Sprintf("Task %d of %d.", m.index+1, len(m.tasks)))
+		if m.status != "" {
+			lines = append(lines, m.status+".")
+		}
+		if m.prompting {
+			lines = append(lines, m.prompt.View())
+			if m.promptErr != "" {
+				lines = append(lines, m.promptErr+".")
+			}
+		} else {
+			lines = append(lines, shortHint(keys.Detail.ShortHelp()))
+		}
+		return strings.Join(lines, "\n")
+	}
 
-	// Footer
-	content.WriteString(detailFooterStyle.Render("Press q or esc to return"))
+	lines := m.bodyLines()
+	if height := m.bodyHeight(); height > 0 && len(lines) > height {
+		lines = lines[m.offset:min(m.offset+height, len(lines))]
+	}
+
+	style := detailContainerStyle
+	if m.width > 0 {
+		style = style.Width(m.frameWidth())
+	}
 
 	// Center the modal
 	return lipgloss.Place(
@@ -107,30 +361,265 @@ func (m DetailViewModel) View() string {
 		m.height,
 		lipgloss.Center,
 		lipgloss.Center,
-		detailContainerStyle.Render(content.String()),
+		style.Render(strings.Join(lines, "\n")+"\n"+m.footer()),
 	)
 }
 
+// detailField is one labelled value in a task's details
+type detailField struct {
+	label string
+	value string
+}
+
+// taskDetails lists the fields the detail view and the preview pane show
+func taskDetails(task models.Task) []detailField {
+	return []detailField{
+		{"Title", task.Title},
+		{"Description", task.Description},
+		{"Priority", getPriorityWithIcon(task.Priority)},
+		{"Status", getStatusWithIcon(task)},
+		{"Tags", strings.Join(task.Tags, ", ")},
+		{"Due Date", formatDate(task.DueDate)},
+		{"Deferred until", formatDeferred(task)},
+		{"Estimate", formatEstimate(task.Estimate)},
+		{"Reminders", models.FormatReminders(task.Reminders)},
+		{"Tracked", formatTracked(task)},
+		{"Pomodoros", formatPomodoros(task)},
+		{"Created", formatDate(task.CreatedAt)},
+		{"Updated", formatDate(task.LastUpdated())},
+		{"Completed", formatCompleted(task)},
+	}
+}
+
+// formatTracked shows the time logged on the task, or nothing when there is none
+func formatTracked(task models.Task) string {
+	now := time.Now()
+	tracked := formatDuration(task.Tracked(now))
+	if start, ok := task.RunningSince(); ok {
+		return tracked + " (timer running for " + formatClock(now.Sub(start)) + ")"
+	}
+	if len(task.TimeEntries) == 0 {
+		return ""
+	}
+	return tracked
+}
+
+// formatPomodoros counts the focus sessions completed on the task, or
+// shows nothing when there are none
+func formatPomodoros(task models.Task) string {
+	if len(task.Pomodoros) == 0 {
+		return ""
+	}
+	return fmt.Sprintf("%d (%d today)", len(task.Pomodoros), task.PomodorosOn(time.Now()))
+}
+
+// formatDeferred shows when a snoozed task appears in the lists again
+func formatDeferred(task models.Task) string {
+	if !task.Deferred(time.Now()) {
+		return ""
+	}
+	return detailTimeStyle.Render(task.DeferUntil.Local().Format("Monday, January 2, 2006 15:04"))
+}
+
+func formatCompleted(task models.Task) string {
+	if !task.Completed || task.CompletedAt == nil {
+		return ""
+	}
+	return formatDate(*task.CompletedAt)
+}
+
+// activityEntry is one edit in a task's timeline: the fields one user
+// changed at one time
+type activityEntry struct {
+	when string
+	by   string
+	what []string
+}
+
+// activityTimeFormat shows when an edit happened in the timeline
+const activityTimeFormat = "2006-01-02 15:04"
+
+// activity lists the task's edits, newest first, ending with its creation
+func activity(task models.Task) []activityEntry {
+	var entries []activityEntry
+	for i := len(task.History) - 1; i >= 0; i-- {
+		change := task.History[i]
+		when := change.At.Local().Format(activityTimeFormat)
+		if n := len(entries); n > 0 && entries[n-1].when == when && entries[n-1].by == change.By {
+			entries[n-1].what = append(entries[n-1].what, describeChange(change))
+			continue
+		}
+		entries = append(entries, activityEntry{when: when, by: change.By, what: []string{describeChange(change)}})
+	}
+	return append(entries, activityEntry{
+		when: task.CreatedAt.Local().Format(activityTimeFormat),
+		what: []string{"Created"},
+	})
+}
+
+// changeLabels name the logged fields
+var changeLabels = map[string]string{
+	models.FieldTitle:      "Title",
+	models.FieldDueDate:    "Due date",
+	models.FieldDeferUntil: "Deferred until",
+	models.FieldPriority:   "Priority",
+	models.FieldEstimate:   "Estimate",
+	models.FieldReminders:  "Reminders",
+	models.FieldChecklist:  "Checklist",
+	models.FieldStatus:     "Status",
+	models.FieldTags:       "Tags",
+}
+
+// describeChange puts a logged change into words
+func describeChange(change models.Change) string {
+	from, to := change.From, change.To
+	switch change.Field {
+	case models.FieldDescription:
+		return "Edited the description"
+	case models.FieldDeletedAt:
+		if to == "" {
+			return "Restored from the trash"
+		}
+		return "Moved to the trash"
+	case models.FieldArchived:
+		if to == "" {
+			return "Unarchived"
+		}
+		return "Archived"
+	case models.FieldStatus:
+		// Statuses are logged by id; show their current names
+		if from != "" {
+			from = workflow.Status(from).Name
+		}
+		to = workflow.Status(to).Name
+	}
+	if from == "" {
+		from = "none"
+	}
+	if to == "" {
+		to = "none"
+	}
+	label, ok := changeLabels[change.Field]
+	if !ok {
+		label = change.Field
+	}
+	return label + ": " + from + " " + glyphs.Arrow + " " + to
+}
+
+// renderActivity draws the task's history as a timeline below its fields.
+// Entries wrap at width when it is positive.
+func renderActivity(task models.Task, width int) string {
+	valueStyle := detailValueStyle.PaddingLeft(2)
+	if width > 0 {
+		valueStyle = valueStyle.Width(width)
+	}
+
+	var b strings.Builder
+	b.WriteString(detailLabelStyle.Render("Activity"))
+	b.WriteString("\n")
+	for _, entry := range activity(task) {
+		header := glyphs.Bullet + " " + entry.when
+		if entry.by != "" {
+			header += "  " + entry.by
+		}
+		b.WriteString(detailTimeStyle.Render(header))
+		b.WriteString("\n")
+		b.WriteString(valueStyle.Render(strings.Join(entry.what, "\n")))
+		b.WriteString("\n")
+	}
+	return b.String()
+}
+
+// renderDetails draws the non-empty fields as label and value blocks.
+// Values wrap at width when it is positive.
+func renderDetails(details []detailField, width int) string {
+	valueStyle := detailValueStyle
+	if width > 0 {
+		valueStyle = valueStyle.Width(width)
+	}
+
+	var b strings.Builder
+	for _, detail := range details {
+		if detail.value != "" {
+			b.WriteString(detailLabelStyle.Render(detail.label))
+			b.WriteString("\n")
+			b.WriteString(valueStyle.Render(detail.value))
+			b.WriteString("\n\n")
+		}
+	}
+	return b.String()
+}
+
 func (m DetailViewModel) ShouldReturn() bool {
 	return m.shouldReturn
 }
 
 func formatDate(t time.Time) string {
+	if t.IsZero() {
+		return ""
+	}
 	return detailTimeStyle.Render(t.Format("Monday, January 2, 2006"))
 }
 
 func getPriorityWithIcon(p models.PriorityLevel) string {
-	icons := map[models.PriorityLevel]string{
-		models.Low:    "🟢",
-		models.Medium: "🟡",
-		models.High:   "🔴",
+	return withIcon(priorityIcon(p), p.String())
+}
+
+func getStatusWithIcon(task models.Task) string {
+	status := workflow.Status(task.Status)
+	return lipgloss.NewStyle().
+		Foreground(theme.StatusColor(status)).
+		Render(statusLabel(status))
+}
+
+// timeTotals lists the time logged on the task per day, newest first, then
+// the time logged under each of its tags across tasks
+func timeTotals(task models.Task, tasks []models.Task, now time.Time) []detailField {
+	var totals []detailField
+
+	byDay := task.TrackedByDay(now)
+	days := make([]time.Time, 0, len(byDay))
+	for day := range byDay {
+		days = append(days, day)
 	}
-	return fmt.Sprintf("%s %s", icons[p], p.String())
+	slices.SortFunc(days, func(a, b time.Time) int { return b.Compare(a) })
+	for _, day := range days {
+		totals = append(totals, detailField{day.Format("Mon 2006-01-02"), formatDuration(byDay[day])})
+	}
+
+	byTag := models.TrackedByTag(tasks, now)
+	for _, tag := range task.Tags {
+		if byTag[tag] > 0 {
+			totals = append(totals, detailField{"#" + tag + " (all tasks)", formatDuration(byTag[tag])})
+		}
+	}
+	return totals
 }
 
-func getStatusWithIcon(completed bool) string {
-	if completed {
-		return "✅ Done"
+// renderTimeTotals draws the per-day and per-tag totals as aligned rows
+func renderTimeTotals(task models.Task, tasks []models.Task, width int) string {
+	totals := timeTotals(task, tasks, time.Now())
+	if len(totals) == 0 {
+		return ""
+	}
+
+	labelWidth := 0
+	for _, total := range totals {
+		labelWidth = max(labelWidth, lipgloss.Width(total.label))
+	}
+	valueStyle := detailValueStyle.PaddingLeft(2)
+	if width > 0 {
+		valueStyle = valueStyle.Width(width)
+	}
+
+	var b strings.Builder
+	b.WriteString(detailLabelStyle.Render("Time by day and tag"))
+	b.WriteString("\n")
+	for _, total := range totals {
+		label := total.label + strings.Repeat(" ", labelWidth-lipgloss.Width(total.label))
+		b.WriteString(valueStyle.Render(detailTimeStyle.Render(label) + "  " + total.value))
+		b.WriteString("\n")
 	}
-	return "⏳ Pending"
+	b.WriteString("\n")
+	return b.String()
 }
@@ -0,0 +1,278 @@
+package views
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+	"github.com/sabry-awad97/task-manager/internal/tui/zone"
+)
+
+var (
+	effortHeaderStyle lipgloss.Style
+	effortOverStyle   lipgloss.Style
+	effortUnderStyle  lipgloss.Style
+)
+
+// setEffortStyles derives the effort report's styles from t
+func setEffortStyles(t Theme) {
+	effortHeaderStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Secondary).
+		PaddingLeft(2)
+
+	effortOverStyle = lipgloss.NewStyle().
+		Foreground(t.Danger)
+
+	effortUnderStyle = lipgloss.NewStyle().
+		Foreground(t.Success)
+}
+
+type effortKeyMap struct {
+	Up    key.Binding
+	Down  key.Binding
+	Enter key.Binding
+	Back  key.Binding
+}
+
+func (k effortKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Enter, k.Back}
+}
+
+func (k effortKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Enter},
+		{k.Back},
+	}
+}
+
+// Widths of the effort report's number columns
+const (
+	effortNumberWidth  = 10
+	effortPercentWidth = 6
+)
+
+// EffortViewModel compares the estimated effort of tasks with the time tracked on them
+type EffortViewModel struct {
+	tasks        []models.Task // Tasks with an estimate or tracked time, by due date
+	cursor       int
+	width        int
+	height       int
+	shouldReturn bool
+}
+
+func NewEffortViewModel() EffortViewModel {
+	return EffortViewModel{}
+}
+
+func (m EffortViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m EffortViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Effort.Back):
+			m.shouldReturn = true
+		case key.Matches(msg, keys.Effort.Up):
+			m.cursor = max(m.cursor-1, 0)
+		case key.Matches(msg, keys.Effort.Down):
+			m.cursor = max(min(m.cursor+1, len(m.tasks)-1), 0)
+		case key.Matches(msg, keys.Effort.Enter):
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return ShowDetailMsg{Task: task}
+				}
+			}
+		}
+
+	case tea.MouseMsg:
+		if msg.Action != tea.MouseActionPress {
+			break
+		}
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.cursor = max(m.cursor-wheelStep, 0)
+		case tea.MouseButtonWheelDown:
+			m.cursor = max(min(m.cursor+wheelStep, len(m.tasks)-1), 0)
+		case tea.MouseButtonLeft:
+			start, end := m.window()
+			for i := start; i < end; i++ {
+				if zone.Get(effortRowZone(i)).InBounds(msg) {
+					m.cursor = i
+				}
+			}
+		}
+	}
+	return m, nil
+}
+
+// UpdateTasks keeps the tasks that have an estimate or tracked time
+func (m *EffortViewModel) UpdateTasks(tasks []models.Task) {
+	m.tasks = nil
+	for _, task := range tasks {
+		if task.Estimate > 0 || len(task.TimeEntries) > 0 {
+			m.tasks = append(m.tasks, task)
+		}
+	}
+	slices.SortStableFunc(m.tasks, func(a, b models.Task) int {
+		return a.DueDate.Compare(b.DueDate)
+	})
+	m.cursor = max(min(m.cursor, len(m.tasks)-1), 0)
+}
+
+func (m EffortViewModel) SelectedTask() (models.Task, bool) {
+	if m.cursor < 0 || m.cursor >= len(m.tasks) {
+		return models.Task{}, false
+	}
+	return m.tasks[m.cursor], true
+}
+
+func (m EffortViewModel) ShouldReturn() bool {
+	return m.shouldReturn
+}
+
+// ResetReturn clears the return request so the report can be shown again
+func (m *EffortViewModel) ResetReturn() {
+	m.shouldReturn = false
+}
+
+func effortRowZone(i int) string {
+	return fmt.Sprintf("effort.row.%d", i)
+}
+
+// window returns the range of tasks that fit on screen around the cursor
+func (m EffortViewModel) window() (int, int) {
+	// Leave room for the frame, the title, the summary, the header and the status line
+	height := len(m.tasks)
+	if m.height > 0 {
+		height = max(m.height-10, 1)
+	}
+	start := max(m.cursor-height+1, 0)
+	return start, min(start+height, len(m.tasks))
+}
+
+// titleWidth is the room left for task titles beside the number columns
+func (m EffortViewModel) titleWidth() int {
+	// Frame, row padding, status icon and the three number columns with their gaps
+	used := 2 + 2 + 3 + 3*(effortNumberWidth+1) + effortPercentWidth
+	return max(m.width-used, 12)
+}
+
+func (m EffortViewModel) View() string {
+	now := time.Now()
+	var content strings.Builder
+
+	content.WriteString(titleStyle.Render(withIcon(glyphs.Effort, "Estimates vs actual")))
+	content.WriteByte('\n')
+	content.WriteString(trashAgeStyle.Render(m.summary(now)))
+	content.WriteString("\n\n")
+
+	if len(m.tasks) == 0 {
+		content.WriteString(trashEmptyStyle.Render("No task has an estimate or tracked time"))
+		content.WriteByte('\n')
+	} else {
+		header := fitCell("", 3) + fitCell("Task", m.titleWidth()) +
+			alignRight("Estimate", effortNumberWidth) + " " +
+			alignRight("Actual", effortNumberWidth) + " " +
+			alignRight("Diff", effortNumberWidth) + " " +
+			alignRight("Used", effortPercentWidth)
+		content.WriteString(effortHeaderStyle.Render(header))
+		content.WriteByte('\n')
+	}
+
+	start, end := m.window()
+	for i := start; i < end; i++ {
+		content.WriteString(zone.Mark(effortRowZone(i), m.renderRow(m.tasks[i], i == m.cursor, now)))
+		content.WriteByte('\n')
+	}
+
+	content.WriteByte('\n')
+	content.WriteString(statusStyle.Render(shortHint(keys.Effort.ShortHelp())))
+
+	return baseStyle.
+		Width(m.width - 2).
+		Height(m.height - 2).
+		Render(content.String())
+}
+
+// summary totals the estimates and tracked time, and how the estimates of
+// completed tasks held up
+func (m EffortViewModel) summary(now time.Time) string {
+	var estimated, tracked, doneEstimated, doneTracked time.Duration
+	for _, task := range m.tasks {
+		estimated += task.Estimate
+		tracked += task.Tracked(now)
+		if task.Completed && task.Estimate > 0 {
+			doneEstimated += task.Estimate
+			doneTracked += task.Tracked(now)
+		}
+	}
+
+	sep := " " + glyphs.ActionSeparator + " "
+	summary := "Estimated " + models.FormatEstimate(estimated) + sep + "Tracked " + models.FormatEstimate(tracked)
+	if doneEstimated > 0 {
+		summary += sep + fmt.Sprintf("Completed tasks took %d%% of their estimates", percentOf(doneTracked, doneEstimated))
+	}
+	return summary
+}
+
+func (m EffortViewModel) renderRow(task models.Task, selected bool, now time.Time) string {
+	tracked := task.Tracked(now)
+
+	diff, used := "", ""
+	diffStyle := lipgloss.NewStyle()
+	if task.Estimate > 0 {
+		delta := tracked - task.Estimate
+		switch {
+		case delta > 0:
+			diff = "+" + models.FormatEstimate(delta)
+			diffStyle = effortOverStyle
+		case delta < 0:
+			diff = "-" + models.FormatEstimate(-delta)
+			diffStyle = effortUnderStyle
+		default:
+			diff = "0m"
+		}
+		used = fmt.Sprintf("%d%%", percentOf(tracked, task.Estimate))
+	}
+
+	icon := statusIcon(workflow.Status(task.Status))
+	numbers := alignRight(formatEstimate(task.Estimate), effortNumberWidth) + " " +
+		alignRight(models.FormatEstimate(tracked), effortNumberWidth) + " "
+
+	if selected {
+		line := fitCell(icon, 3) + fitCell(task.Title, m.titleWidth()) + numbers +
+			alignRight(diff, effortNumberWidth) + " " + alignRight(used, effortPercentWidth)
+		if glyphs.Markers {
+			line = cursorMarker + " " + line
+		}
+		return trashSelectedItemStyle.Render(line)
+	}
+	return trashItemStyle.Render(fitCell(icon, 3) + fitCell(task.Title, m.titleWidth()) + numbers +
+		diffStyle.Render(alignRight(diff, effortNumberWidth)) + " " +
+		diffStyle.Render(alignRight(used, effortPercentWidth)))
+}
+
+// percentOf is part as a whole-number percentage of whole
+func percentOf(part, whole time.Duration) int {
+	if whole <= 0 {
+		return 0
+	}
+	return int(float64(part) / float64(whole) * 100)
+}
+
+// alignRight pads s on the left to width cells
+func alignRight(s string, width int) string {
+	return lipgloss.NewStyle().Width(width).Align(lipgloss.Right).Render(s)
+}
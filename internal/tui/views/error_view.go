@@ -4,27 +4,36 @@ import (
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
 var (
+	errorViewStyle    lipgloss.Style
+	errorTitleStyle   lipgloss.Style
+	errorMessageStyle lipgloss.Style
+	errorHintStyle    lipgloss.Style
+)
+
+// setErrorStyles derives the error view's styles from t
+func setErrorStyles(t Theme) {
 	errorViewStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("196")).
-			Padding(1, 2)
+		Border(glyphs.Border).
+		BorderForeground(t.Danger).
+		Padding(1, 2)
 
 	errorTitleStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
-			Bold(true)
+		Foreground(t.Danger).
+		Bold(true)
 
 	errorMessageStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("241"))
+		Foreground(t.Muted)
 
 	errorHintStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")).
-			Italic(true)
-)
+		Foreground(t.Muted).
+		Italic(true)
+}
 
 type ErrorViewModel struct {
 	err         error
@@ -60,7 +69,7 @@ func (m ErrorViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.shouldClose = true
 
 	case tea.KeyMsg:
-		if msg.String() == "esc" || msg.String() == "enter" {
+		if key.Matches(msg, keys.Error.Dismiss) {
 			m.shouldClose = true
 		}
 	}
@@ -71,7 +80,7 @@ func (m ErrorViewModel) View() string {
 	var content strings.Builder
 
 	// Error icon and title
-	content.WriteString(errorTitleStyle.Render("❌ Error"))
+	content.WriteString(errorTitleStyle.Render(withIcon(glyphs.Error, "Error")))
 	content.WriteString("\n\n")
 
 	// Error message
@@ -0,0 +1,399 @@
+package views
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+var (
+	focusPhaseStyle lipgloss.Style
+	focusWorkStyle  lipgloss.Style
+	focusBreakStyle lipgloss.Style
+	focusPauseStyle lipgloss.Style
+	focusTaskStyle  lipgloss.Style
+	focusInfoStyle  lipgloss.Style
+)
+
+// setFocusStyles derives the focus view's styles from t
+func setFocusStyles(t Theme) {
+	focusPhaseStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Secondary)
+
+	focusWorkStyle = lipgloss.NewStyle().
+		Foreground(t.Accent)
+
+	focusBreakStyle = lipgloss.NewStyle().
+		Foreground(t.Success)
+
+	focusPauseStyle = lipgloss.NewStyle().
+		Foreground(t.Muted)
+
+	focusTaskStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Text)
+
+	focusInfoStyle = lipgloss.NewStyle().
+		Foreground(t.Muted)
+}
+
+type focusKeyMap struct {
+	Pause key.Binding
+	Skip  key.Binding
+	Done  key.Binding
+	Back  key.Binding
+}
+
+func (k focusKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Pause, k.Skip, k.Done, k.Back}
+}
+
+func (k focusKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Pause, k.Skip, k.Done},
+		{k.Back},
+	}
+}
+
+// ShowFocusMsg opens the focus view on a task
+type ShowFocusMsg struct {
+	Task models.Task
+}
+
+// PomodoroDoneMsg logs a focus session completed on a task
+type PomodoroDoneMsg struct {
+	TaskID     string
+	Start, End time.Time
+}
+
+// RingBellMsg asks the root model to ring the terminal bell
+type RingBellMsg struct{}
+
+// CompleteTaskMsg moves a task to a done state
+type CompleteTaskMsg struct {
+	TaskID string
+}
+
+// PomodoroSettings are the lengths of the focus view's phases
+type PomodoroSettings struct {
+	Work           time.Duration
+	Break          time.Duration
+	LongBreak      time.Duration
+	LongBreakEvery int  // Pomodoros before a long break; 0 never takes one
+	Bell           bool // Ring the terminal bell when a phase ends
+}
+
+type focusPhase int
+
+const (
+	focusWork focusPhase = iota
+	focusBreak
+	focusLongBreak
+)
+
+func (p focusPhase) String() string {
+	switch p {
+	case focusBreak:
+		return "Short break"
+	case focusLongBreak:
+		return "Long break"
+	default:
+		return "Focus"
+	}
+}
+
+// FocusTickMsg counts a running phase down. Ticks carry the id of the run
+// that scheduled them so that pausing and resuming never leaves two running,
+// and a closed focus view lets its ticks die out.
+type FocusTickMsg struct {
+	id int
+	at time.Time
+}
+
+// focusRuns numbers the runs of every focus view
+var focusRuns int
+
+// FocusViewModel runs pomodoro cycles on one task
+type FocusViewModel struct {
+	task         models.Task
+	found        bool // The task is still in the list
+	settings     PomodoroSettings
+	phase        focusPhase
+	started      time.Time     // When the running work phase started, pauses included
+	ends         time.Time     // When the running phase ends
+	remaining    time.Duration // Time left in the phase while paused
+	paused       bool
+	run          int // Id of the current run's ticks
+	completed    int // Pomodoros finished in this session
+	width        int
+	height       int
+	shouldReturn bool
+}
+
+// NewFocusViewModel starts a work phase on task
+func NewFocusViewModel(task models.Task, settings PomodoroSettings) FocusViewModel {
+	focusRuns++
+	now := time.Now()
+	return FocusViewModel{
+		task:     task,
+		found:    true,
+		settings: settings,
+		started:  now,
+		ends:     now.Add(settings.Work),
+		run:      focusRuns,
+	}
+}
+
+func (m FocusViewModel) Init() tea.Cmd {
+	return m.tick()
+}
+
+// tick schedules the next countdown step of the current run
+func (m FocusViewModel) tick() tea.Cmd {
+	id := m.run
+	return tea.Tick(time.Second, func(t time.Time) tea.Msg {
+		return FocusTickMsg{id: id, at: t}
+	})
+}
+
+func (m FocusViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case FocusTickMsg:
+		if msg.id != m.run || m.paused {
+			break
+		}
+		if msg.at.Before(m.ends) {
+			return m, m.tick()
+		}
+		cmd := m.advance(msg.at, true)
+		return m, tea.Batch(cmd, m.tick())
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Focus.Back):
+			m.shouldReturn = true
+		case key.Matches(msg, keys.Focus.Pause):
+			return m, m.togglePause(time.Now())
+		case key.Matches(msg, keys.Focus.Skip):
+			cmd := m.advance(time.Now(), false)
+			if m.paused {
+				return m, cmd
+			}
+			return m, tea.Batch(cmd, m.tick())
+		case !m.found || m.task.Completed:
+		case key.Matches(msg, keys.Focus.Done):
+			id := m.task.ID
+			return m, func() tea.Msg { return CompleteTaskMsg{TaskID: id} }
+		}
+	}
+	return m, nil
+}
+
+// advance ends the current phase at now and starts the next one. A work
+// phase that ran to the end is logged on the task.
+func (m *FocusViewModel) advance(now time.Time, finished bool) tea.Cmd {
+	var cmds []tea.Cmd
+	if m.settings.Bell {
+		cmds = append(cmds, ringBell)
+	}
+
+	if m.phase == focusWork {
+		m.phase = focusBreak
+		if finished {
+			m.completed++
+			id, start := m.task.ID, m.started
+			cmds = append(cmds, func() tea.Msg {
+				return PomodoroDoneMsg{TaskID: id, Start: start, End: now}
+			})
+			if every := m.settings.LongBreakEvery; every > 0 && m.completed%every == 0 {
+				m.phase = focusLongBreak
+			}
+		}
+	} else {
+		m.phase = focusWork
+		m.started = now
+	}
+
+	m.ends = now.Add(m.length())
+	m.remaining = m.length()
+	// A new run drops the ticks still on their way for the old phase
+	focusRuns++
+	m.run = focusRuns
+	return tea.Batch(cmds...)
+}
+
+// togglePause stops or restarts the countdown
+func (m *FocusViewModel) togglePause(now time.Time) tea.Cmd {
+	if m.paused {
+		m.paused = false
+		m.ends = now.Add(m.remaining)
+		focusRuns++
+		m.run = focusRuns
+		return m.tick()
+	}
+	m.paused = true
+	m.remaining = m.ends.Sub(now)
+	return nil
+}
+
+// length is how long the current phase lasts
+func (m FocusViewModel) length() time.Duration {
+	switch m.phase {
+	case focusBreak:
+		return m.settings.Break
+	case focusLongBreak:
+		return m.settings.LongBreak
+	default:
+		return m.settings.Work
+	}
+}
+
+// left is the time remaining in the current phase
+func (m FocusViewModel) left(now time.Time) time.Duration {
+	if m.paused {
+		return m.remaining
+	}
+	return max(m.ends.Sub(now), 0)
+}
+
+func ringBell() tea.Msg {
+	return RingBellMsg{}
+}
+
+// UpdateTasks refreshes the task from tasks, by ID
+func (m *FocusViewModel) UpdateTasks(tasks []models.Task) {
+	i := slices.IndexFunc(tasks, func(t models.Task) bool { return t.ID == m.task.ID })
+	m.found = i >= 0
+	if m.found {
+		m.task = tasks[i]
+	}
+}
+
+func (m FocusViewModel) ShouldReturn() bool {
+	return m.shouldReturn
+}
+
+// ResetReturn clears the return request so the view can be shown again
+func (m *FocusViewModel) ResetReturn() {
+	m.shouldReturn = false
+}
+
+// bigDigits draws the countdown five rows high
+var bigDigits = map[rune][5]string{
+	'0': {"███", "█ █", "█ █", "█ █", "███"},
+	'1': {" █ ", "██ ", " █ ", " █ ", "███"},
+	'2': {"███", "  █", "███", "█  ", "███"},
+	'3': {"███", "  █", "███", "  █", "███"},
+	'4': {"█ █", "█ █", "███", "  █", "  █"},
+	'5': {"███", "█  ", "███", "  █", "███"},
+	'6': {"███", "█  ", "███", "█ █", "███"},
+	'7': {"███", "  █", "  █", "  █", "  █"},
+	'8': {"███", "█ █", "███", "█ █", "███"},
+	'9': {"███", "█ █", "███", "  █", "███"},
+	':': {" ", "█", " ", "█", " "},
+}
+
+// bigClock renders s in big digits, each cell two characters wide
+func bigClock(s string) string {
+	block := "██"
+	if display.ASCII {
+		block = "##"
+	}
+	var rows [5]strings.Builder
+	for i, r := range s {
+		for row := range rows {
+			if i > 0 {
+				rows[row].WriteString("  ")
+			}
+			for _, c := range bigDigits[r][row] {
+				if c == ' ' {
+					rows[row].WriteString("  ")
+				} else {
+					rows[row].WriteString(block)
+				}
+			}
+		}
+	}
+	lines := make([]string, len(rows))
+	for i := range rows {
+		lines[i] = rows[i].String()
+	}
+	return strings.Join(lines, "\n")
+}
+
+// formatCountdown shows the time left as minutes and seconds, e.g. "24:59"
+func formatCountdown(d time.Duration) string {
+	seconds := int(d.Round(time.Second) / time.Second)
+	return fmt.Sprintf("%02d:%02d", seconds/60, seconds%60)
+}
+
+func (m FocusViewModel) View() string {
+	now := time.Now()
+	left := formatCountdown(m.left(now))
+
+	phase := m.phase.String()
+	if m.paused {
+		phase += " (paused)"
+	}
+
+	sep := " " + glyphs.ActionSeparator + " "
+	info := fmt.Sprintf("%d this session%s%d today%s%d in all", m.completed, sep,
+		m.task.PomodorosOn(now), sep, len(m.task.Pomodoros))
+	switch {
+	case !m.found:
+		info = "This task is no longer in the list"
+	case m.task.Completed:
+		info += sep + "task done"
+	}
+
+	if display.ScreenReader {
+		lines := []string{
+			"Focus: " + m.task.Title,
+			phase + ", " + left + " left",
+			"Pomodoros: " + info,
+			shortHint(keys.Focus.ShortHelp()),
+		}
+		return strings.Join(lines, "\n")
+	}
+
+	clockStyle := focusWorkStyle
+	switch {
+	case m.paused:
+		clockStyle = focusPauseStyle
+	case m.phase != focusWork:
+		clockStyle = focusBreakStyle
+	}
+
+	body := lipgloss.JoinVertical(lipgloss.Center,
+		focusPhaseStyle.Render(phase),
+		"",
+		clockStyle.Render(bigClock(left)),
+		"",
+		focusTaskStyle.Render(m.task.Title),
+		focusInfoStyle.Render(info),
+	)
+
+	var content strings.Builder
+	content.WriteString(titleStyle.Render(withIcon(glyphs.Focus, "Focus")))
+	content.WriteByte('\n')
+	// Centre the countdown in the space left by the title and the status line
+	content.WriteString(lipgloss.Place(max(m.width-4, 0), max(m.height-6, 0), lipgloss.Center, lipgloss.Center, body))
+	content.WriteByte('\n')
+	content.WriteString(statusStyle.Render(shortHint(keys.Focus.ShortHelp())))
+
+	return baseStyle.
+		Width(m.width - 2).
+		Height(m.height - 2).
+		Render(content.String())
+}
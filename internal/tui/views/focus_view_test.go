@@ -0,0 +1,42 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+func TestPomodoroStartsWhenTheTimerStarted(t *testing.T) {
+	task := models.NewTask("a", "", time.Time{}, models.Low)
+	m := NewFocusViewModel(task, PomodoroSettings{Work: 25 * time.Minute, Break: 5 * time.Minute, Bell: true})
+	start := m.started
+
+	// Pause for ten minutes five minutes in
+	m.togglePause(start.Add(5 * time.Minute))
+	m.togglePause(start.Add(15 * time.Minute))
+	if want := start.Add(35 * time.Minute); !m.ends.Equal(want) {
+		t.Fatalf("ends at %v, want %v", m.ends, want)
+	}
+
+	var done *PomodoroDoneMsg
+	rang := false
+	for _, cmd := range m.advance(m.ends, true)().(tea.BatchMsg) {
+		switch msg := cmd().(type) {
+		case PomodoroDoneMsg:
+			done = &msg
+		case RingBellMsg:
+			rang = true
+		}
+	}
+	if done == nil {
+		t.Fatal("no pomodoro logged")
+	}
+	if !done.Start.Equal(start) || !done.End.Equal(start.Add(35*time.Minute)) {
+		t.Errorf("logged %v to %v, want %v to %v", done.Start, done.End, start, start.Add(35*time.Minute))
+	}
+	if !rang {
+		t.Error("the bell did not ring")
+	}
+}
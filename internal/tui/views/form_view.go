@@ -5,96 +5,123 @@ import (
 	"strings"
 	"time"
 
+	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 	"github.com/sabry-awad97/task-manager/internal/tui/models"
+	"github.com/sabry-awad97/task-manager/internal/tui/zone"
 )
 
 var (
+	focusedStyle         lipgloss.Style
+	blurredStyle         lipgloss.Style
+	cursorStyle          lipgloss.Style
+	errorStyle           lipgloss.Style
+	inputStyle           lipgloss.Style
+	activeInputStyle     lipgloss.Style
+	formContainerStyle   lipgloss.Style
+	labelStyle           lipgloss.Style
+	inputContainerStyle  lipgloss.Style
+	buttonContainerStyle lipgloss.Style
+	footerStyle          lipgloss.Style
+	selectStyle          lipgloss.Style
+	activeSelectStyle    lipgloss.Style
+	optionStyle          lipgloss.Style
+	selectedOptionStyle  lipgloss.Style
+	buttonStyle          lipgloss.Style
+	activeButtonStyle    lipgloss.Style
+)
+
+// setFormStyles derives the form's styles from t
+func setFormStyles(t Theme) {
 	focusedStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("205"))
+		Foreground(t.Accent)
 
 	blurredStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("240"))
+		Foreground(t.Border)
 
 	cursorStyle = focusedStyle
 
-	_ = lipgloss.NewStyle()
-
 	errorStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("196")).
-			Italic(true)
+		Foreground(t.Danger).
+		Italic(true)
 
 	inputStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("240")).
-			Padding(0, 1).
-			Width(42)
+		Border(glyphs.Border).
+		BorderForeground(t.Border).
+		Padding(0, 1).
+		Width(42)
 
 	activeInputStyle = inputStyle.
-				BorderForeground(lipgloss.Color("205"))
+		BorderForeground(t.Accent)
 
 	formContainerStyle = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(lipgloss.Color("240")).
-				Padding(0, 1).
-				MarginLeft(2).
-				MarginRight(2)
+		Border(glyphs.Border).
+		BorderForeground(t.Border).
+		Padding(0, 1).
+		MarginLeft(2).
+		MarginRight(2)
 
 	labelStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("99"))
+		Bold(true).
+		Foreground(t.Secondary)
 
 	inputContainerStyle = lipgloss.NewStyle().
-				MarginBottom(1)
+		MarginBottom(1)
 
 	buttonContainerStyle = lipgloss.NewStyle().
-				MarginTop(1).
-				Align(lipgloss.Center)
+		MarginTop(1).
+		Align(lipgloss.Center)
 
 	footerStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")).
-			Align(lipgloss.Center).
-			MarginTop(1)
+		Foreground(t.Muted).
+		Align(lipgloss.Center).
+		MarginTop(1)
 
 	selectStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("240")).
-			Padding(0, 1).
-			Width(42)
+		Border(glyphs.Border).
+		BorderForeground(t.Border).
+		Padding(0, 1).
+		Width(42)
 
 	activeSelectStyle = selectStyle.
-				BorderForeground(lipgloss.Color("205"))
+		BorderForeground(t.Accent)
 
 	optionStyle = lipgloss.NewStyle().
-			PaddingLeft(1).
-			PaddingRight(1)
+		PaddingLeft(1).
+		PaddingRight(1)
 
 	selectedOptionStyle = optionStyle.
-				Background(lipgloss.Color("205")).
-				Foreground(lipgloss.Color("0"))
-
-	priorityOptionStyle = map[models.PriorityLevel]lipgloss.Style{
-		models.Low:    optionStyle.Foreground(lipgloss.Color("42")),
-		models.Medium: optionStyle.Foreground(lipgloss.Color("214")),
-		models.High:   optionStyle.Foreground(lipgloss.Color("196")),
-	}
+		Background(t.Accent).
+		Foreground(t.OnAccent)
 
 	buttonStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("240")).
-			Padding(0, 3).
-			SetString("💾 Save")
+		Border(glyphs.Border).
+		BorderForeground(t.Border).
+		Padding(0, 3)
 
 	activeButtonStyle = buttonStyle.Copy().
-				BorderForeground(lipgloss.Color("205"))
+		BorderForeground(t.Accent)
+}
+
+// Form fields in focus order
+const (
+	fieldTitle = iota
+	fieldDescription
+	fieldDueDate
+	fieldEstimate
+	fieldReminders
+	fieldPriority
+	fieldSave
 )
 
 type FormViewModel struct {
 	title         textinput.Model
 	description   textinput.Model
 	dueDate       textinput.Model
+	estimate      textinput.Model
+	reminders     textinput.Model
 	priority      int
 	focusIndex    int
 	errors        map[string]string
@@ -102,7 +129,7 @@ type FormViewModel struct {
 	height        int
 	done          bool
 	isEditing     bool
-	taskID        string // Store original task ID when editing
+	original      models.Task // Task being edited, keeps fields the form does not show
 	mouseInButton bool
 }
 
@@ -128,10 +155,24 @@ func NewFormViewModel() FormViewModel {
 	dueDate.Width = 40
 	dueDate.Cursor.Style = cursorStyle
 
+	estimate := textinput.New()
+	estimate.Placeholder = "e.g. 30m, 2h or 1d (optional)"
+	estimate.CharLimit = 20
+	estimate.Width = 40
+	estimate.Cursor.Style = cursorStyle
+
+	reminders := textinput.New()
+	reminders.Placeholder = "e.g. 15m, 1d, at 09:00 (optional)"
+	reminders.CharLimit = 60
+	reminders.Width = 40
+	reminders.Cursor.Style = cursorStyle
+
 	return FormViewModel{
 		title:       title,
 		description: description,
 		dueDate:     dueDate,
+		estimate:    estimate,
+		reminders:   reminders,
 		errors:      make(map[string]string),
 		isEditing:   false,
 	}
@@ -141,10 +182,15 @@ func NewFormViewModel() FormViewModel {
 func (m *FormViewModel) InitForEdit(task models.Task) {
 	m.title.SetValue(task.Title)
 	m.description.SetValue(task.Description)
-	m.dueDate.SetValue(task.DueDate.Format("2006-01-02"))
-	m.priority = int(task.Priority)
+	m.dueDate.SetValue(formatDue(task.DueDate, "2006-01-02"))
+	if task.Estimate > 0 {
+		m.estimate.SetValue(models.FormatEstimate(task.Estimate))
+	}
+	m.reminders.SetValue(models.FormatReminders(task.Reminders))
+	// Levels beyond a shortened scale fall back to the top one
+	m.priority = min(max(int(task.Priority), 0), len(priorityOptions())-1)
 	m.isEditing = true
-	m.taskID = task.ID
+	m.original = task
 }
 
 func (m FormViewModel) Init() tea.Cmd {
@@ -160,78 +206,35 @@ func (m FormViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.height = msg.Height
 
 	case tea.KeyMsg:
-		switch msg.String() {
-		case "tab", "shift+tab", "up", "down":
-			// Handle focus change
-			s := msg.String()
-			if s == "up" || s == "shift+tab" {
-				m.focusIndex--
-			} else {
-				m.focusIndex++
-			}
-
-			if m.focusIndex > 4 {
-				m.focusIndex = 0
-			} else if m.focusIndex < 0 {
-				m.focusIndex = 4
+		switch {
+		case key.Matches(msg, keys.Form.Next, keys.Form.Prev):
+			// Handle focus change, wrapping around
+			index := m.focusIndex + 1
+			if key.Matches(msg, keys.Form.Prev) {
+				index = m.focusIndex - 1
 			}
+			return m, m.focus((index + fieldSave + 1) % (fieldSave + 1))
 
-			// Blur all inputs
-			m.title.Blur()
-			m.description.Blur()
-			m.dueDate.Blur()
-
-			// Focus the active input
-			switch m.focusIndex {
-			case 0:
-				cmd = m.title.Focus()
-			case 1:
-				cmd = m.description.Focus()
-			case 2:
-				cmd = m.dueDate.Focus()
-			}
-			return m, cmd
-
-		case "enter":
-			if m.focusIndex == 4 && m.validate() {
+		case key.Matches(msg, keys.Form.Submit):
+			if m.focusIndex == fieldSave && m.validate() {
 				m.done = true
 				return m, nil
 			}
 			// Handle enter key for field navigation
-			if m.focusIndex < 4 {
-				// Blur current input
-				switch m.focusIndex {
-				case 0:
-					m.title.Blur()
-				case 1:
-					m.description.Blur()
-				case 2:
-					m.dueDate.Blur()
-				}
-
-				// Move to next field
-				m.focusIndex++
-
-				// Focus next input
-				switch m.focusIndex {
-				case 1:
-					cmd = m.description.Focus()
-				case 2:
-					cmd = m.dueDate.Focus()
-				}
-				return m, cmd
+			if m.focusIndex < fieldSave {
+				return m, m.focus(m.focusIndex + 1)
 			}
 
-		case "left", "right":
-			if m.focusIndex == 3 {
-				if msg.String() == "left" {
+		case key.Matches(msg, keys.Form.PriorityLeft, keys.Form.PriorityRight):
+			if m.focusIndex == fieldPriority {
+				if key.Matches(msg, keys.Form.PriorityLeft) {
 					m.priority--
 					if m.priority < 0 {
-						m.priority = 2
+						m.priority = len(priorityOptions()) - 1
 					}
 				} else {
 					m.priority++
-					if m.priority > 2 {
+					if m.priority >= len(priorityOptions()) {
 						m.priority = 0
 					}
 				}
@@ -241,43 +244,44 @@ func (m FormViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.MouseMsg:
 		switch msg.Action {
 		case tea.MouseActionPress:
-			if msg.Button == tea.MouseButtonLeft {
-				// Check if clicked save button
-				if m.mouseInButton && m.validate() {
+			if msg.Button != tea.MouseButtonLeft {
+				break
+			}
+			if zone.Get(saveZone).InBounds(msg) {
+				if m.validate() {
 					m.done = true
-					return m, nil
 				}
-
-				// Check if clicked priority options
-				if y := msg.Y - m.getPriorityY(); y >= 0 && y < 1 {
-					x := msg.X - m.getPriorityX()
-					switch {
-					case x >= 0 && x < 15: // Low
-						m.priority = 0
-					case x >= 15 && x < 30: // Medium
-						m.priority = 1
-					case x >= 30 && x < 45: // High
-						m.priority = 2
-					}
+				return m, nil
+			}
+			for i := range priorityOptions() {
+				if zone.Get(priorityZone(i)).InBounds(msg) {
+					m.priority = i
+					return m, m.focus(fieldPriority)
+				}
+			}
+			for i := range fieldPriority {
+				if zone.Get(fieldZone(i)).InBounds(msg) {
+					return m, m.focus(i)
 				}
 			}
 
 		case tea.MouseActionMotion:
-			// Update button hover state
-			m.mouseInButton = msg.Y == m.getButtonY() &&
-				msg.X >= m.getButtonX() &&
-				msg.X < m.getButtonX()+10
+			m.mouseInButton = zone.Get(saveZone).InBounds(msg)
 		}
 	}
 
 	// Only update active input
 	switch m.focusIndex {
-	case 0:
+	case fieldTitle:
 		m.title, cmd = m.title.Update(msg)
-	case 1:
+	case fieldDescription:
 		m.description, cmd = m.description.Update(msg)
-	case 2:
+	case fieldDueDate:
 		m.dueDate, cmd = m.dueDate.Update(msg)
+	case fieldEstimate:
+		m.estimate, cmd = m.estimate.Update(msg)
+	case fieldReminders:
+		m.reminders, cmd = m.reminders.Update(msg)
 	}
 
 	return m, cmd
@@ -290,9 +294,9 @@ func (m FormViewModel) View() string {
 	var content strings.Builder
 
 	// Title header
-	title := "✨ New Task"
+	title := withIcon(glyphs.NewTask, "New Task")
 	if m.isEditing {
-		title = "✏️ Edit Task"
+		title = withIcon(glyphs.EditTask, "Edit Task")
 	}
 	content.WriteString(titleStyle.Render(title))
 	content.WriteString("\n")
@@ -300,19 +304,31 @@ func (m FormViewModel) View() string {
 	// Title input
 	content.WriteString(inputContainerStyle.Render(
 		labelStyle.Render("Title") + "\n" +
-			m.renderInput(m.title, 0, "title"),
+			m.renderInput(m.title, fieldTitle, "title"),
 	))
 
 	// Description input
 	content.WriteString(inputContainerStyle.Render(
 		labelStyle.Render("Description") + "\n" +
-			m.renderInput(m.description, 1, ""),
+			m.renderInput(m.description, fieldDescription, ""),
 	))
 
 	// Due date input
 	content.WriteString(inputContainerStyle.Render(
 		labelStyle.Render("Due Date") + "\n" +
-			m.renderInput(m.dueDate, 2, "dueDate"),
+			m.renderInput(m.dueDate, fieldDueDate, "dueDate"),
+	))
+
+	// Estimate input
+	content.WriteString(inputContainerStyle.Render(
+		labelStyle.Render("Estimate") + "\n" +
+			m.renderInput(m.estimate, fieldEstimate, "estimate"),
+	))
+
+	// Reminders input
+	content.WriteString(inputContainerStyle.Render(
+		labelStyle.Render("Reminders") + "\n" +
+			m.renderInput(m.reminders, fieldReminders, "reminders"),
 	))
 
 	// Priority selection
@@ -331,7 +347,8 @@ func (m FormViewModel) View() string {
 	b.WriteString(formContainerStyle.Width(formWidth).Render(content.String()))
 
 	// Footer with keyboard hints
-	hint := "↑/↓: Navigate • Tab: Next • Esc: Cancel"
+	hint := fmt.Sprintf("%s: Next • %s: Previous • %s: Cancel",
+		keys.Form.Next.Help().Key, keys.Form.Prev.Help().Key, keys.Form.Cancel.Help().Key)
 	b.WriteString("\n")
 	b.WriteString(footerStyle.Render(hint))
 
@@ -355,61 +372,127 @@ func (m *FormViewModel) validate() bool {
 		}
 	}
 
+	if _, err := models.ParseEstimate(m.estimate.Value()); err != nil {
+		m.errors["estimate"] = err.Error()
+		valid = false
+	}
+
+	if _, err := models.ParseReminders(m.reminders.Value()); err != nil {
+		m.errors["reminders"] = err.Error()
+		valid = false
+	}
+
 	return valid
 }
 
+// due reads the due date field. The field holds only the date, so an edited
+// task keeps the time of day it was due at.
+func (m FormViewModel) due() time.Time {
+	due, err := time.Parse("2006-01-02", m.dueDate.Value())
+	if err != nil || !m.isEditing {
+		return due
+	}
+	clock := m.original.DueDate
+	return due.Add(time.Duration(clock.Hour())*time.Hour + time.Duration(clock.Minute())*time.Minute)
+}
+
 func (m *FormViewModel) GetTask() models.Task {
-	dueDate, _ := time.Parse("2006-01-02", m.dueDate.Value())
+	dueDate := m.due()
+	estimate, _ := models.ParseEstimate(m.estimate.Value())
+	reminders, _ := models.ParseReminders(m.reminders.Value())
+	// Preserve the original task when editing
+	if m.isEditing {
+		task := m.original
+		task.Title = m.title.Value()
+		task.Description = m.description.Value()
+		task.DueDate = dueDate
+		task.Estimate = estimate
+		task.Reminders = reminders
+		task.Priority = models.PriorityLevel(m.priority)
+		return task
+	}
+
 	task := models.NewTask(
 		m.title.Value(),
 		m.description.Value(),
 		dueDate,
 		models.PriorityLevel(m.priority),
 	)
+	task.Status = workflow.Initial
+	task.Estimate = estimate
+	task.Reminders = reminders
+	return task
+}
 
-	// Preserve original ID if editing
-	if m.isEditing {
-		task.ID = m.taskID
-	}
+// Clickable regions of the form
+const saveZone = "form.save"
 
-	return task
+func fieldZone(index int) string {
+	return fmt.Sprintf("form.field.%d", index)
 }
 
-func (m FormViewModel) renderPriorities() string {
-	priorities := []struct {
-		value models.PriorityLevel
-		label string
-		icon  string
-	}{
-		{models.Low, "Low", "🟢"},
-		{models.Medium, "Medium", "🟡"},
-		{models.High, "High", "🔴"},
+func priorityZone(index int) string {
+	return fmt.Sprintf("form.priority.%d", index)
+}
+
+// priorityOptions are the choices in the priority selector, lowest first
+func priorityOptions() []models.PriorityLevel {
+	options := make([]models.PriorityLevel, len(models.PriorityScale()))
+	for i := range options {
+		options[i] = models.PriorityLevel(i)
+	}
+	return options
+}
+
+// focus moves the focus to the field at index, blurring the others
+func (m *FormViewModel) focus(index int) tea.Cmd {
+	m.focusIndex = index
+	m.title.Blur()
+	m.description.Blur()
+	m.dueDate.Blur()
+	m.estimate.Blur()
+	m.reminders.Blur()
+	switch index {
+	case fieldTitle:
+		return m.title.Focus()
+	case fieldDescription:
+		return m.description.Focus()
+	case fieldDueDate:
+		return m.dueDate.Focus()
+	case fieldEstimate:
+		return m.estimate.Focus()
+	case fieldReminders:
+		return m.reminders.Focus()
 	}
+	return nil
+}
 
+func (m FormViewModel) renderPriorities() string {
 	style := selectStyle
-	if m.focusIndex == 3 {
+	if m.focusIndex == fieldPriority {
 		style = activeSelectStyle
 	}
 
 	// Build options list
 	var options []string
-	for i, p := range priorities {
-		optStyle := priorityOptionStyle[p.value]
+	for i, p := range priorityOptions() {
+		optStyle := optionStyle.Foreground(theme.PriorityColor(p))
 		if i == m.priority {
-			if m.focusIndex == 3 {
+			if m.focusIndex == fieldPriority {
 				optStyle = selectedOptionStyle
 			} else {
 				optStyle = optStyle.Bold(true)
 			}
 		}
-		option := fmt.Sprintf("%s %s", p.icon, p.label)
-		options = append(options, optStyle.Render(option))
+		option := withIcon(priorityIcon(p), p.String())
+		options = append(options, zone.Mark(priorityZone(i), optStyle.Render(option)))
 	}
 
 	// Add navigation hint
-	content := strings.Join(options, " │ ")
-	if m.focusIndex == 3 {
-		content += blurredStyle.Render("\n(← → to select)")
+	content := strings.Join(options, " "+glyphs.ActionSeparator+" ")
+	if m.focusIndex == fieldPriority {
+		content += blurredStyle.Render(fmt.Sprintf("\n(%s %s to select)",
+			keys.Form.PriorityLeft.Help().Key, keys.Form.PriorityRight.Help().Key))
 	}
 
 	return style.Render(content)
@@ -418,11 +501,11 @@ func (m FormViewModel) renderPriorities() string {
 func (m FormViewModel) renderInput(input textinput.Model, index int, errorKey string) string {
 	var b strings.Builder
 
+	style := inputStyle
 	if m.focusIndex == index {
-		b.WriteString(activeInputStyle.Render(input.View()))
-	} else {
-		b.WriteString(inputStyle.Render(input.View()))
+		style = activeInputStyle
 	}
+	b.WriteString(zone.Mark(fieldZone(index), style.Render(input.View())))
 
 	if err := m.errors[errorKey]; err != "" && errorKey != "" {
 		b.WriteString("\n")
@@ -434,24 +517,8 @@ func (m FormViewModel) renderInput(input textinput.Model, index int, errorKey st
 
 func (m FormViewModel) renderSaveButton() string {
 	style := buttonStyle
-	if m.focusIndex == 4 || m.mouseInButton {
+	if m.focusIndex == fieldSave || m.mouseInButton {
 		style = activeButtonStyle
 	}
-	return style.Render("💾 Save")
-}
-
-func (m FormViewModel) getButtonY() int {
-	return m.height - 4 // Adjust based on your layout
-}
-
-func (m FormViewModel) getButtonX() int {
-	return (m.width - 10) / 2 // Center position
-}
-
-func (m FormViewModel) getPriorityY() int {
-	return m.height - 8 // Adjust based on your layout
-}
-
-func (m FormViewModel) getPriorityX() int {
-	return 4 // Left margin
+	return zone.Mark(saveZone, style.Render(withIcon(glyphs.Save, "Save")))
 }
@@ -0,0 +1,40 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+func TestEditKeepsDueTimeOfDay(t *testing.T) {
+	due := time.Date(2026, 10, 20, 14, 30, 0, 0, time.UTC)
+	task := models.NewTask("call", "", due, models.Low)
+
+	m := NewFormViewModel()
+	m.InitForEdit(task)
+	if got := m.GetTask().DueDate; !got.Equal(due) {
+		t.Errorf("unchanged date saved as %v, want %v", got, due)
+	}
+
+	m.dueDate.SetValue("2026-10-22")
+	if got, want := m.GetTask().DueDate, due.AddDate(0, 0, 2); !got.Equal(want) {
+		t.Errorf("moved date saved as %v, want %v", got, want)
+	}
+
+	m.dueDate.SetValue("")
+	if got := m.GetTask().DueDate; !got.IsZero() {
+		t.Errorf("cleared date saved as %v", got)
+	}
+}
+
+func TestEditUndatedTaskLeavesDateEmpty(t *testing.T) {
+	m := NewFormViewModel()
+	m.InitForEdit(models.NewTask("someday", "", time.Time{}, models.Low))
+	if got := m.dueDate.Value(); got != "" {
+		t.Errorf("due date field = %q, want it empty", got)
+	}
+	if got := m.GetTask().DueDate; !got.IsZero() {
+		t.Errorf("undated task saved as due %v", got)
+	}
+}
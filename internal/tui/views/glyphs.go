@@ -0,0 +1,162 @@
+package views
+
+import (
+	"github.com/charmbracelet/lipgloss"
+	"github.com/muesli/termenv"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+// Display selects how the views draw themselves for different terminals and readers
+type Display struct {
+	ASCII        bool // Text glyphs and borders instead of emoji and box drawing
+	NoColor      bool // No colours; state is shown with text markers
+	ScreenReader bool // Linear layout without tables or borders
+}
+
+// Glyphs are the icons, markers and borders the views draw with
+type Glyphs struct {
+	App, Board, Calendar, Agenda, Detail, Help, Error string // Title icons
+	Trash, Archive, Matrix, Timer, Effort, Focus      string
+	Deferred                                          string
+	NewTask, EditTask, Save, Summary                  string
+	Done, Pending                                     string // Status icons in the detail view
+	Priority                                          []string
+
+	ViewAction, EditAction, DeleteAction, ActionSeparator string
+
+	Mark     string // Marked table row
+	Bullet   string // Board card priority
+	Ellipsis string // Truncated cells
+	Arrow    string // Old and new value in the activity log
+
+	// Markers adds text markers for the cursor, status and priority so that
+	// no state is shown by colour alone
+	Markers bool
+
+	Border lipgloss.Border // Panels, inputs and cards
+	Grid   lipgloss.Border // Calendar day cells
+}
+
+var emojiGlyphs = Glyphs{
+	App:      "✨",
+	Board:    "📋",
+	Calendar: "📅",
+	Agenda:   "🗓️",
+	Detail:   "📝",
+	Help:     "🎯",
+	Error:    "❌",
+	Trash:    "🗑️",
+	Archive:  "📦",
+	Matrix:   "🧭",
+	Timer:    "⏱️",
+	Effort:   "📊",
+	Focus:    "🍅",
+	Deferred: "💤",
+	NewTask:  "✨",
+	EditTask: "✏️",
+	Save:     "💾",
+	Summary:  "✅",
+	Done:     "✅",
+	Pending:  "⏳",
+	Priority: []string{"🟢", "🟡", "🔴"},
+
+	ViewAction:      "👁️",
+	EditAction:      "✏️",
+	DeleteAction:    "❌",
+	ActionSeparator: "│",
+
+	Mark:     "●",
+	Bullet:   "●",
+	Ellipsis: "…",
+	Arrow:    "→",
+
+	Border: lipgloss.RoundedBorder(),
+	Grid:   lipgloss.NormalBorder(),
+}
+
+var asciiBorder = lipgloss.Border{
+	Top:          "-",
+	Bottom:       "-",
+	Left:         "|",
+	Right:        "|",
+	TopLeft:      "+",
+	TopRight:     "+",
+	BottomLeft:   "+",
+	BottomRight:  "+",
+	MiddleLeft:   "+",
+	MiddleRight:  "+",
+	Middle:       "+",
+	MiddleTop:    "+",
+	MiddleBottom: "+",
+}
+
+var asciiGlyphs = Glyphs{
+	Done:     "[x]",
+	Pending:  "[ ]",
+	Priority: []string{"!", "!!", "!!!"},
+
+	ViewAction:      "v",
+	EditAction:      "e",
+	DeleteAction:    "x",
+	ActionSeparator: "|",
+
+	Mark:     "*",
+	Bullet:   "*",
+	Ellipsis: "...",
+	Arrow:    "->",
+	Markers:  true,
+
+	Border: asciiBorder,
+	Grid:   asciiBorder,
+}
+
+// Text markers used when Markers is set
+const (
+	cursorMarker  = ">"
+	doneMarker    = "[x]"
+	pendingMarker = "[ ]"
+)
+
+var (
+	glyphs  = emojiGlyphs
+	display Display
+)
+
+// SetDisplay switches glyphs, colours and layout. Call it before building the views.
+func SetDisplay(d Display) {
+	display = d
+	glyphs = emojiGlyphs
+	if d.ASCII || d.ScreenReader {
+		glyphs = asciiGlyphs
+	}
+	if d.NoColor {
+		glyphs.Markers = true
+		lipgloss.SetColorProfile(termenv.Ascii)
+	}
+	// Borders live in the styles
+	SetTheme(theme)
+}
+
+// withIcon prefixes text with an icon when the glyph set has one
+func withIcon(icon, text string) string {
+	if icon == "" {
+		return text
+	}
+	return icon + " " + text
+}
+
+// priorityIcon returns the glyph for a priority level
+func priorityIcon(p models.PriorityLevel) string {
+	if len(glyphs.Priority) == 0 {
+		return ""
+	}
+	return glyphs.Priority[p.Band(len(glyphs.Priority))]
+}
+
+// priorityLabel names a priority, with a text marker when colour is not enough
+func priorityLabel(p models.PriorityLevel) string {
+	if glyphs.Markers {
+		return asciiGlyphs.Priority[p.Band(len(asciiGlyphs.Priority))] + " " + p.String()
+	}
+	return p.String()
+}
@@ -1,34 +1,46 @@
 package views
 
 import (
+	"fmt"
+	"slices"
 	"strings"
 
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 )
 
 var (
+	helpModalStyle   lipgloss.Style
+	helpHeadingStyle lipgloss.Style
+	helpSectionStyle lipgloss.Style
+	helpKeyStyle     lipgloss.Style
+	helpDescStyle    lipgloss.Style
+)
+
+// setHelpStyles derives the help modal's styles from t
+func setHelpStyles(t Theme) {
 	helpModalStyle = lipgloss.NewStyle().
-			Border(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("205")).
-			Padding(1, 2)
+		Border(glyphs.Border).
+		BorderForeground(t.Accent).
+		Padding(1, 2)
 
 	helpHeadingStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("205")).
-				Bold(true).
-				Align(lipgloss.Center)
+		Foreground(t.Accent).
+		Bold(true).
+		Align(lipgloss.Center)
 
 	helpSectionStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("99")).
-				Bold(true).
-				PaddingTop(1)
+		Foreground(t.Secondary).
+		Bold(true)
 
 	helpKeyStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("205")).
-			Bold(true)
+		Foreground(t.Accent).
+		Bold(true)
 
 	helpDescStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241"))
-)
+		Foreground(t.Muted)
+}
 
 type HelpSection struct {
 	Title string
@@ -40,93 +52,197 @@ type HelpItem struct {
 	Description string
 }
 
-func RenderHelpModal(width, height int) string {
-	sections := []HelpSection{
-		{
-			Title: "Navigation",
-			Items: []HelpItem{
-				{"↑/k", "Move up"},
-				{"↓/j", "Move down"},
-				{"enter", "View details"},
-				{"tab", "Next field"},
-				{"shift+tab", "Previous field"},
-			},
+// mouseHelp lists the mouse actions a view supports
+func mouseHelp(context string) []HelpItem {
+	return map[string][]HelpItem{
+		ContextMain: {
+			{"click row", "Select task"},
+			{"click " + glyphs.ViewAction, "View details"},
+			{"click " + glyphs.EditAction, "Edit task"},
+			{"click " + glyphs.DeleteAction, "Delete task"},
+			{"wheel", "Move through tasks"},
+			{"drag divider", "Resize preview"},
 		},
-		{
-			Title: "Tasks",
-			Items: []HelpItem{
-				{"n", "New task"},
-				{"d", "Delete task"},
-				{"e", "Edit task"},
-				{"space", "Toggle complete"},
-			},
+		ContextBoard: {
+			{"click card", "Select card"},
+			{"drag card", "Move to another column"},
 		},
-		{
-			Title: "General",
-			Items: []HelpItem{
-				{"?", "Toggle help"},
-				{"esc", "Exit/Cancel"},
-				{"ctrl+c", "Quit"},
-			},
+		ContextDetail: {
+			{"wheel", "Scroll details"},
 		},
-	}
+		ContextTrash: {
+			{"click task", "Select task"},
+			{"wheel", "Move through tasks"},
+		},
+		ContextMatrix: {
+			{"click task", "Select task"},
+			{"wheel", "Move through quadrant"},
+		},
+		ContextArchive: {
+			{"click task", "Select task"},
+			{"wheel", "Move through tasks"},
+		},
+		ContextEffort: {
+			{"click task", "Select task"},
+			{"wheel", "Move through tasks"},
+		},
+		ContextDeferred: {
+			{"click task", "Select task"},
+			{"wheel", "Move through tasks"},
+		},
+		ContextTimeLog: {
+			{"click entry", "Select entry"},
+			{"wheel", "Move through entries"},
+		},
+		ContextForm: {
+			{"click field", "Focus field"},
+			{"click option", "Choose priority"},
+			{"click Save", "Save task"},
+		},
+	}[context]
+}
 
-	var content strings.Builder
+const helpModalWidth = 44
 
-	// Title
-	content.WriteString(helpHeadingStyle.Render("🎯 Keyboard Shortcuts"))
-	content.WriteString("\n\n")
+// HelpModel is the scrollable keyboard help for one view
+type HelpModel struct {
+	context string
+	lines   []string
+	offset  int
+	width   int
+	height  int
+	closed  bool
+}
 
-	// Render each section
-	for i, section := range sections {
-		if i > 0 {
-			content.WriteString("\n")
-		}
+// NewHelpModel builds the help for the view named by context from its commands
+func NewHelpModel(context string, width, height int) HelpModel {
+	return HelpModel{
+		context: context,
+		lines:   renderHelpSections(helpSections(context)),
+		width:   width,
+		height:  height,
+	}
+}
 
-		content.WriteString(helpSectionStyle.Render(section.Title))
-		content.WriteString("\n")
+func (m HelpModel) Init() tea.Cmd {
+	return nil
+}
 
-		// Calculate maximum key length for alignment
-		maxKeyLen := 0
-		for _, item := range section.Items {
-			if len(item.Key) > maxKeyLen {
-				maxKeyLen = len(item.Key)
-			}
+func (m HelpModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Help.Close, keys.Global.Help):
+			m.closed = true
+		case key.Matches(msg, keys.Help.Up):
+			m.offset--
+		case key.Matches(msg, keys.Help.Down):
+			m.offset++
+		case key.Matches(msg, keys.Help.PageUp):
+			m.offset -= m.visibleLines()
+		case key.Matches(msg, keys.Help.PageDown):
+			m.offset += m.visibleLines()
 		}
 
-		// Render items
-		for _, item := range section.Items {
-			key := helpKeyStyle.Render(item.Key)
-			padding := strings.Repeat(" ", maxKeyLen-len(item.Key)+2)
-			desc := helpDescStyle.Render(item.Description)
-			content.WriteString(key + padding + desc + "\n")
+	case tea.MouseMsg:
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.offset--
+		case tea.MouseButtonWheelDown:
+			m.offset++
 		}
 	}
 
-	// Center the modal
-	modalWidth := 40
-	modalHeight := strings.Count(content.String(), "\n") + 3
+	m.offset = max(min(m.offset, len(m.lines)-m.visibleLines()), 0)
+	return m, nil
+}
 
-	modal := helpModalStyle.
-		Width(modalWidth).
-		Render(content.String())
+// Closed reports whether the help was dismissed
+func (m HelpModel) Closed() bool {
+	return m.closed
+}
 
-	// Calculate position for centering
-	leftPadding := (width - modalWidth) / 2
-	if leftPadding < 0 {
-		leftPadding = 0
-	}
-	topPadding := (height - modalHeight) / 2
-	if topPadding < 0 {
-		topPadding = 0
+// visibleLines is how many help lines fit inside the modal on this terminal
+func (m HelpModel) visibleLines() int {
+	// Border, padding, heading and footer
+	return max(m.height-8, 3)
+}
+
+func (m HelpModel) View() string {
+	var content strings.Builder
+
+	content.WriteString(helpHeadingStyle.Width(helpModalWidth - 4).Render(withIcon(glyphs.Help, "Keyboard Shortcuts · "+m.context)))
+	content.WriteString("\n\n")
+
+	end := min(m.offset+m.visibleLines(), len(m.lines))
+	content.WriteString(strings.Join(m.lines[m.offset:end], "\n"))
+
+	// Only show scroll hints when the help does not fit
+	if len(m.lines) > m.visibleLines() {
+		content.WriteString("\n")
+		content.WriteString(helpDescStyle.Render(fmt.Sprintf("%s %s scroll · %d-%d of %d",
+			keys.Help.Up.Help().Key, keys.Help.Down.Help().Key, m.offset+1, end, len(m.lines))))
 	}
 
-	// Apply padding for centering
 	return lipgloss.Place(
-		width,
-		height,
+		m.width,
+		m.height,
 		lipgloss.Center,
 		lipgloss.Center,
-		modal,
+		helpModalStyle.Width(helpModalWidth).Render(content.String()),
 	)
 }
+
+// helpSections builds the help for a view from the commands registered for
+// it, grouped by section, followed by the global commands and the view's
+// mouse actions
+func helpSections(context string) []HelpSection {
+	var sections []HelpSection
+	global := HelpSection{Title: "Anywhere"}
+	for _, c := range CommandsFor(context) {
+		item := HelpItem{c.Binding.Help().Key, c.Name}
+		if !slices.Contains(c.Contexts, context) {
+			global.Items = append(global.Items, item)
+			continue
+		}
+		i := slices.IndexFunc(sections, func(s HelpSection) bool { return s.Title == c.Section })
+		if i < 0 {
+			sections = append(sections, HelpSection{Title: c.Section})
+			i = len(sections) - 1
+		}
+		sections[i].Items = append(sections[i].Items, item)
+	}
+	sections = append(sections, global)
+
+	if mouse := mouseHelp(context); len(mouse) > 0 {
+		sections = append(sections, HelpSection{Title: "Mouse", Items: mouse})
+	}
+	return sections
+}
+
+// renderHelpSections lays out sections as lines with keys aligned per section
+func renderHelpSections(sections []HelpSection) []string {
+	var lines []string
+	for i, section := range sections {
+		if i > 0 {
+			lines = append(lines, "")
+		}
+		lines = append(lines, helpSectionStyle.Render(section.Title))
+
+		// Calculate maximum key length for alignment
+		maxKeyLen := 0
+		for _, item := range section.Items {
+			maxKeyLen = max(maxKeyLen, lipgloss.Width(item.Key))
+		}
+
+		for _, item := range section.Items {
+			padding := strings.Repeat(" ", maxKeyLen-lipgloss.Width(item.Key)+2)
+			lines = append(lines, helpKeyStyle.Render(item.Key)+padding+helpDescStyle.Render(item.Description))
+		}
+	}
+	return lines
+}
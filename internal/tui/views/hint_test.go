@@ -0,0 +1,54 @@
+package views
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sabry-awad97/task-manager/internal/config"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+// TestHintsFollowRemappedKeys checks that status hints name the keys the
+// user bound rather than the defaults
+func TestHintsFollowRemappedKeys(t *testing.T) {
+	remapped, err := NewKeyMap("default", map[string][]string{
+		"global.help":     {"f2"},
+		"agenda.table":    {"T"},
+		"board.move_left": {"H"},
+		"calendar.mode":   {"W"},
+		"main.reschedule": {"ctrl+r"},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+	saved := keys
+	SetKeyMap(remapped)
+	t.Cleanup(func() { SetKeyMap(saved) })
+
+	task := models.NewTask("a", "", time.Time{}, models.Low)
+	size := tea.WindowSizeMsg{Width: 120, Height: 40}
+
+	main := NewMainViewModel(config.Highlight{}, nil)
+	main.UpdateTasks([]models.Task{task})
+	if got := main.renderStatus(); !strings.Contains(got, "f2") {
+		t.Errorf("main status %q does not name f2", got)
+	}
+	next, _ := main.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune("m")})
+	main = next.(MainViewModel)
+	if got := main.renderStatus(); !strings.Contains(got, "ctrl+r reschedule") {
+		t.Errorf("selection status %q does not name ctrl+r", got)
+	}
+
+	for name, view := range map[string]tea.Model{
+		"T task table":     NewAgendaViewModel(),
+		"H move card left": NewBoardViewModel(),
+		"W month/week":     NewCalendarViewModel(),
+	} {
+		view, _ = view.Update(size)
+		if got := view.View(); !strings.Contains(got, name) {
+			t.Errorf("%T hint does not name %q", view, name)
+		}
+	}
+}
@@ -0,0 +1,1063 @@
+package views
+
+import (
+	"fmt"
+	"reflect"
+	"slices"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// KeyMap holds every key binding in the app, grouped by the view that handles it.
+// Each binding has an id such as "main.new" or "form.cancel" that the config
+// file uses to override it.
+type KeyMap struct {
+	Global   globalKeyMap
+	Main     keyMap
+	Form     formKeyMap
+	Detail   detailKeyMap
+	Board    boardKeyMap
+	Calendar calendarKeyMap
+	Agenda   agendaKeyMap
+	Trash    trashKeyMap
+	Archive  archiveKeyMap
+	Matrix   matrixKeyMap
+	TimeLog  timeLogKeyMap
+	Effort   effortKeyMap
+	Deferred deferredKeyMap
+	Focus    focusKeyMap
+	Palette  paletteKeyMap
+	Help     helpKeyMap
+	Prompt   promptKeyMap
+	Confirm  confirmKeyMap
+	Error    errorKeyMap
+}
+
+// globalKeyMap is handled by the root model in every view
+type globalKeyMap struct {
+	Quit    key.Binding
+	Palette key.Binding
+	Help    key.Binding
+	Theme   key.Binding
+}
+
+type formKeyMap struct {
+	Next          key.Binding
+	Prev          key.Binding
+	Submit        key.Binding
+	Cancel        key.Binding
+	PriorityLeft  key.Binding
+	PriorityRight key.Binding
+}
+
+func (k formKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Next, k.Prev, k.Cancel}
+}
+
+func (k formKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Next, k.Prev, k.Submit},
+		{k.PriorityLeft, k.PriorityRight},
+		{k.Cancel},
+	}
+}
+
+type detailKeyMap struct {
+	Up        key.Binding
+	Down      key.Binding
+	PageUp    key.Binding
+	PageDown  key.Binding
+	Next      key.Binding
+	Prev      key.Binding
+	Edit      key.Binding
+	Toggle    key.Binding
+	Advance   key.Binding
+	Delete    key.Binding
+	CopyID    key.Binding
+	CopyTitle key.Binding
+	Timer     key.Binding
+	TimeLog   key.Binding
+	Focus     key.Binding
+	Back      key.Binding
+
+	// Checklist
+	NextItem   key.Binding
+	PrevItem   key.Binding
+	CheckItem  key.Binding
+	AddItem    key.Binding
+	MoveUp     key.Binding
+	MoveDown   key.Binding
+	RemoveItem key.Binding
+}
+
+func (k detailKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Edit, k.Toggle, k.Delete, k.Timer, k.TimeLog, k.Next, k.Prev, k.Back}
+}
+
+func (k detailKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.PageUp, k.PageDown, k.Next, k.Prev},
+		{k.Edit, k.Toggle, k.Advance, k.Delete, k.CopyID, k.CopyTitle, k.Timer, k.TimeLog, k.Focus},
+		{k.NextItem, k.PrevItem, k.CheckItem, k.AddItem, k.MoveUp, k.MoveDown, k.RemoveItem},
+		{k.Back},
+	}
+}
+
+// helpKeyMap scrolls and closes the help modal
+type helpKeyMap struct {
+	Up       key.Binding
+	Down     key.Binding
+	PageUp   key.Binding
+	PageDown key.Binding
+	Close    key.Binding
+}
+
+type paletteKeyMap struct {
+	Up    key.Binding
+	Down  key.Binding
+	Run   key.Binding
+	Close key.Binding
+}
+
+// promptKeyMap drives the main view's bulk action prompt
+type promptKeyMap struct {
+	Submit key.Binding
+	Cancel key.Binding
+}
+
+type errorKeyMap struct {
+	Dismiss key.Binding
+}
+
+// keys is the live key map every view reads from
+var keys = DefaultKeyMap()
+
+// Keys returns the live key map
+func Keys() KeyMap {
+	return keys
+}
+
+// SetKeyMap replaces the live key map. Call it before building the views.
+func SetKeyMap(k KeyMap) {
+	keys = k
+}
+
+func DefaultKeyMap() KeyMap {
+	return KeyMap{
+		Global: globalKeyMap{
+			Quit: key.NewBinding(
+				key.WithKeys("ctrl+c"),
+				key.WithHelp("ctrl+c", "quit"),
+			),
+			Palette: key.NewBinding(
+				key.WithKeys("ctrl+p"),
+				key.WithHelp("ctrl+p", "command palette"),
+			),
+			Help: key.NewBinding(
+				key.WithKeys("f1", "?"),
+				key.WithHelp("?/f1", "keyboard help"),
+			),
+			Theme: key.NewBinding(
+				key.WithKeys("ctrl+t"),
+				key.WithHelp("ctrl+t", "next theme"),
+			),
+		},
+		Main: keyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "up"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "down"),
+			),
+			New: key.NewBinding(
+				key.WithKeys("n"),
+				key.WithHelp("n", "new task"),
+			),
+			Delete: key.NewBinding(
+				key.WithKeys("d"),
+				key.WithHelp("d", "delete task"),
+			),
+			Edit: key.NewBinding(
+				key.WithKeys("e"),
+				key.WithHelp("e", "edit task"),
+			),
+			Quit: key.NewBinding(
+				key.WithKeys("q"),
+				key.WithHelp("q", "quit"),
+			),
+			Enter: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "view details"),
+			),
+			Space: key.NewBinding(
+				key.WithKeys(" "),
+				key.WithHelp("space", "next status"),
+			),
+			Complete: key.NewBinding(
+				key.WithKeys("X"),
+				key.WithHelp("X", "complete"),
+			),
+			Board: key.NewBinding(
+				key.WithKeys("b"),
+				key.WithHelp("b", "board view"),
+			),
+			Calendar: key.NewBinding(
+				key.WithKeys("c"),
+				key.WithHelp("c", "calendar view"),
+			),
+			Agenda: key.NewBinding(
+				key.WithKeys("a"),
+				key.WithHelp("a", "today agenda"),
+			),
+			Visual: key.NewBinding(
+				key.WithKeys("v"),
+				key.WithHelp("v", "visual select"),
+			),
+			SelectUp: key.NewBinding(
+				key.WithKeys("shift+up"),
+				key.WithHelp("shift+↑", "extend selection up"),
+			),
+			SelectDown: key.NewBinding(
+				key.WithKeys("shift+down"),
+				key.WithHelp("shift+↓", "extend selection down"),
+			),
+			Mark: key.NewBinding(
+				key.WithKeys("m"),
+				key.WithHelp("m", "mark/unmark"),
+			),
+			SelectAll: key.NewBinding(
+				key.WithKeys("ctrl+a"),
+				key.WithHelp("ctrl+a", "select all"),
+			),
+			Clear: key.NewBinding(
+				key.WithKeys("esc"),
+				key.WithHelp("esc", "clear selection"),
+			),
+			Priority: key.NewBinding(
+				key.WithKeys("p"),
+				key.WithHelp("p", "set priority"),
+			),
+			Tag: key.NewBinding(
+				key.WithKeys("t"),
+				key.WithHelp("t", "add tag"),
+			),
+			Reschedule: key.NewBinding(
+				key.WithKeys("r"),
+				key.WithHelp("r", "reschedule"),
+			),
+			Snooze: key.NewBinding(
+				key.WithKeys("z"),
+				key.WithHelp("z", "snooze"),
+			),
+			Undo: key.NewBinding(
+				key.WithKeys("u"),
+				key.WithHelp("u", "undo bulk action"),
+			),
+			PageUp: key.NewBinding(
+				key.WithKeys("pgup"),
+				key.WithHelp("pgup", "page up"),
+			),
+			PageDown: key.NewBinding(
+				key.WithKeys("pgdown"),
+				key.WithHelp("pgdn", "page down"),
+			),
+			Top: key.NewBinding(
+				key.WithKeys("home", "g"),
+				key.WithHelp("g", "first task"),
+			),
+			Bottom: key.NewBinding(
+				key.WithKeys("end", "G"),
+				key.WithHelp("G", "last task"),
+			),
+			Trash: key.NewBinding(
+				key.WithKeys("T"),
+				key.WithHelp("T", "trash"),
+			),
+			Matrix: key.NewBinding(
+				key.WithKeys("E"),
+				key.WithHelp("E", "eisenhower matrix"),
+			),
+			Effort: key.NewBinding(
+				key.WithKeys("R"),
+				key.WithHelp("R", "estimates report"),
+			),
+			Deferred: key.NewBinding(
+				key.WithKeys("D"),
+				key.WithHelp("D", "deferred tasks"),
+			),
+			Archive: key.NewBinding(
+				key.WithKeys("Z"),
+				key.WithHelp("Z", "archive"),
+			),
+			ArchiveTask: key.NewBinding(
+				key.WithKeys("A"),
+				key.WithHelp("A", "archive task"),
+			),
+			Timer: key.NewBinding(
+				key.WithKeys("s"),
+				key.WithHelp("s", "start/stop timer"),
+			),
+			Focus: key.NewBinding(
+				key.WithKeys("f"),
+				key.WithHelp("f", "focus mode"),
+			),
+			Preview: key.NewBinding(
+				key.WithKeys("P"),
+				key.WithHelp("P", "toggle preview"),
+			),
+			PreviewGrow: key.NewBinding(
+				key.WithKeys("<"),
+				key.WithHelp("<", "widen preview"),
+			),
+			PreviewShrink: key.NewBinding(
+				key.WithKeys(">"),
+				key.WithHelp(">", "narrow preview"),
+			),
+		},
+		Form: formKeyMap{
+			Next: key.NewBinding(
+				key.WithKeys("tab", "down"),
+				key.WithHelp("tab", "next field"),
+			),
+			Prev: key.NewBinding(
+				key.WithKeys("shift+tab", "up"),
+				key.WithHelp("shift+tab", "previous field"),
+			),
+			Submit: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "next field/save"),
+			),
+			Cancel: key.NewBinding(
+				key.WithKeys("esc"),
+				key.WithHelp("esc", "cancel"),
+			),
+			PriorityLeft: key.NewBinding(
+				key.WithKeys("left"),
+				key.WithHelp("←", "lower priority"),
+			),
+			PriorityRight: key.NewBinding(
+				key.WithKeys("right"),
+				key.WithHelp("→", "higher priority"),
+			),
+		},
+		Detail: detailKeyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "scroll up"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "scroll down"),
+			),
+			PageUp: key.NewBinding(
+				key.WithKeys("pgup"),
+				key.WithHelp("pgup", "page up"),
+			),
+			PageDown: key.NewBinding(
+				key.WithKeys("pgdown"),
+				key.WithHelp("pgdn", "page down"),
+			),
+			Next: key.NewBinding(
+				key.WithKeys("n", "right"),
+				key.WithHelp("n/→", "next task"),
+			),
+			Prev: key.NewBinding(
+				key.WithKeys("N", "left"),
+				key.WithHelp("N/←", "previous task"),
+			),
+			Edit: key.NewBinding(
+				key.WithKeys("e"),
+				key.WithHelp("e", "edit"),
+			),
+			Toggle: key.NewBinding(
+				key.WithKeys(" "),
+				key.WithHelp("space", "toggle completed"),
+			),
+			Advance: key.NewBinding(
+				key.WithKeys(">"),
+				key.WithHelp(">", "next status"),
+			),
+			Delete: key.NewBinding(
+				key.WithKeys("d"),
+				key.WithHelp("d", "delete"),
+			),
+			CopyID: key.NewBinding(
+				key.WithKeys("y"),
+				key.WithHelp("y", "copy id"),
+			),
+			CopyTitle: key.NewBinding(
+				key.WithKeys("Y"),
+				key.WithHelp("Y", "copy title"),
+			),
+			Timer: key.NewBinding(
+				key.WithKeys("s"),
+				key.WithHelp("s", "start/stop timer"),
+			),
+			TimeLog: key.NewBinding(
+				key.WithKeys("t"),
+				key.WithHelp("t", "time log"),
+			),
+			Focus: key.NewBinding(
+				key.WithKeys("f"),
+				key.WithHelp("f", "focus mode"),
+			),
+			Back: key.NewBinding(
+				key.WithKeys("esc", "q"),
+				key.WithHelp("esc/q", "back"),
+			),
+			NextItem: key.NewBinding(
+				key.WithKeys("tab"),
+				key.WithHelp("tab", "next item"),
+			),
+			PrevItem: key.NewBinding(
+				key.WithKeys("shift+tab"),
+				key.WithHelp("shift+tab", "previous item"),
+			),
+			CheckItem: key.NewBinding(
+				key.WithKeys("c"),
+				key.WithHelp("c", "check item"),
+			),
+			AddItem: key.NewBinding(
+				key.WithKeys("a"),
+				key.WithHelp("a", "add item"),
+			),
+			MoveUp: key.NewBinding(
+				key.WithKeys("K"),
+				key.WithHelp("K", "move item up"),
+			),
+			MoveDown: key.NewBinding(
+				key.WithKeys("J"),
+				key.WithHelp("J", "move item down"),
+			),
+			RemoveItem: key.NewBinding(
+				key.WithKeys("X"),
+				key.WithHelp("X", "remove item"),
+			),
+		},
+		Board: boardKeyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "up"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "down"),
+			),
+			Left: key.NewBinding(
+				key.WithKeys("left", "shift+tab"),
+				key.WithHelp("←", "previous column"),
+			),
+			Right: key.NewBinding(
+				key.WithKeys("right", "tab"),
+				key.WithHelp("→", "next column"),
+			),
+			MoveLeft: key.NewBinding(
+				key.WithKeys("h"),
+				key.WithHelp("h", "move card left"),
+			),
+			MoveRight: key.NewBinding(
+				key.WithKeys("l"),
+				key.WithHelp("l", "move card right"),
+			),
+			Enter: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "view details"),
+			),
+			Back: key.NewBinding(
+				key.WithKeys("b", "esc"),
+				key.WithHelp("b", "back to table"),
+			),
+		},
+		Calendar: calendarKeyMap{
+			Left: key.NewBinding(
+				key.WithKeys("left", "h"),
+				key.WithHelp("←/h", "previous day"),
+			),
+			Right: key.NewBinding(
+				key.WithKeys("right", "l"),
+				key.WithHelp("→/l", "next day"),
+			),
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "previous week"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "next week"),
+			),
+			PrevMonth: key.NewBinding(
+				key.WithKeys("["),
+				key.WithHelp("[", "previous month"),
+			),
+			NextMonth: key.NewBinding(
+				key.WithKeys("]"),
+				key.WithHelp("]", "next month"),
+			),
+			Today: key.NewBinding(
+				key.WithKeys("t"),
+				key.WithHelp("t", "today"),
+			),
+			Mode: key.NewBinding(
+				key.WithKeys("w"),
+				key.WithHelp("w", "month/week"),
+			),
+			Enter: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "list day"),
+			),
+			Move: key.NewBinding(
+				key.WithKeys("m"),
+				key.WithHelp("m", "move task"),
+			),
+			Back: key.NewBinding(
+				key.WithKeys("esc", "c"),
+				key.WithHelp("esc", "back"),
+			),
+		},
+		Agenda: agendaKeyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "up"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "down"),
+			),
+			Enter: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "view details"),
+			),
+			Space: key.NewBinding(
+				key.WithKeys(" "),
+				key.WithHelp("space", "next status"),
+			),
+			Table: key.NewBinding(
+				key.WithKeys("a", "esc", "tab"),
+				key.WithHelp("a", "task table"),
+			),
+		},
+		Trash: trashKeyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "up"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "down"),
+			),
+			Restore: key.NewBinding(
+				key.WithKeys("r", "enter"),
+				key.WithHelp("r", "restore"),
+			),
+			Purge: key.NewBinding(
+				key.WithKeys("d", "delete"),
+				key.WithHelp("d", "delete forever"),
+			),
+			Empty: key.NewBinding(
+				key.WithKeys("D"),
+				key.WithHelp("D", "empty trash"),
+			),
+			Back: key.NewBinding(
+				key.WithKeys("esc", "q", "T"),
+				key.WithHelp("esc", "task table"),
+			),
+		},
+		Matrix: matrixKeyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "up"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "down"),
+			),
+			Left: key.NewBinding(
+				key.WithKeys("left", "h"),
+				key.WithHelp("←/h", "urgent side"),
+			),
+			Right: key.NewBinding(
+				key.WithKeys("right", "l"),
+				key.WithHelp("→/l", "not urgent side"),
+			),
+			Enter: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "view details"),
+			),
+			Space: key.NewBinding(
+				key.WithKeys(" "),
+				key.WithHelp("space", "next status"),
+			),
+			Back: key.NewBinding(
+				key.WithKeys("esc", "q", "E"),
+				key.WithHelp("esc", "task table"),
+			),
+		},
+		Archive: archiveKeyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "up"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "down"),
+			),
+			Unarchive: key.NewBinding(
+				key.WithKeys("u", "enter"),
+				key.WithHelp("u", "unarchive"),
+			),
+			Search: key.NewBinding(
+				key.WithKeys("/"),
+				key.WithHelp("/", "search"),
+			),
+			Back: key.NewBinding(
+				key.WithKeys("esc", "q", "Z"),
+				key.WithHelp("esc", "task table"),
+			),
+			SearchUp: key.NewBinding(
+				key.WithKeys("up"),
+				key.WithHelp("↑", "previous match"),
+			),
+			SearchDown: key.NewBinding(
+				key.WithKeys("down"),
+				key.WithHelp("↓", "next match"),
+			),
+		},
+		TimeLog: timeLogKeyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "up"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "down"),
+			),
+			Add: key.NewBinding(
+				key.WithKeys("a"),
+				key.WithHelp("a", "log time"),
+			),
+			Edit: key.NewBinding(
+				key.WithKeys("e", "enter"),
+				key.WithHelp("e", "edit entry"),
+			),
+			Delete: key.NewBinding(
+				key.WithKeys("d", "delete"),
+				key.WithHelp("d", "delete entry"),
+			),
+			Timer: key.NewBinding(
+				key.WithKeys("s"),
+				key.WithHelp("s", "start/stop timer"),
+			),
+			Back: key.NewBinding(
+				key.WithKeys("esc", "q", "t"),
+				key.WithHelp("esc", "back"),
+			),
+		},
+		Effort: effortKeyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "up"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "down"),
+			),
+			Enter: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "view details"),
+			),
+			Back: key.NewBinding(
+				key.WithKeys("esc", "q", "R"),
+				key.WithHelp("esc", "task table"),
+			),
+		},
+		Deferred: deferredKeyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "up"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "down"),
+			),
+			Enter: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "view details"),
+			),
+			Wake: key.NewBinding(
+				key.WithKeys("w"),
+				key.WithHelp("w", "show now"),
+			),
+			Back: key.NewBinding(
+				key.WithKeys("esc", "q", "D"),
+				key.WithHelp("esc", "task table"),
+			),
+		},
+		Focus: focusKeyMap{
+			Pause: key.NewBinding(
+				key.WithKeys(" ", "p"),
+				key.WithHelp("space", "pause/resume"),
+			),
+			Skip: key.NewBinding(
+				key.WithKeys("n"),
+				key.WithHelp("n", "skip phase"),
+			),
+			Done: key.NewBinding(
+				key.WithKeys("d"),
+				key.WithHelp("d", "mark task done"),
+			),
+			Back: key.NewBinding(
+				key.WithKeys("esc", "q", "f"),
+				key.WithHelp("esc", "stop and go back"),
+			),
+		},
+		Palette: paletteKeyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "ctrl+k"),
+				key.WithHelp("↑", "previous command"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "ctrl+j"),
+				key.WithHelp("↓", "next command"),
+			),
+			Run: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "run command"),
+			),
+			Close: key.NewBinding(
+				key.WithKeys("esc"),
+				key.WithHelp("esc", "close"),
+			),
+		},
+		Help: helpKeyMap{
+			Up: key.NewBinding(
+				key.WithKeys("up", "k"),
+				key.WithHelp("↑/k", "scroll up"),
+			),
+			Down: key.NewBinding(
+				key.WithKeys("down", "j"),
+				key.WithHelp("↓/j", "scroll down"),
+			),
+			PageUp: key.NewBinding(
+				key.WithKeys("pgup"),
+				key.WithHelp("pgup", "page up"),
+			),
+			PageDown: key.NewBinding(
+				key.WithKeys("pgdown", " "),
+				key.WithHelp("pgdn", "page down"),
+			),
+			Close: key.NewBinding(
+				key.WithKeys("esc", "q"),
+				key.WithHelp("esc/q", "close"),
+			),
+		},
+		Prompt: promptKeyMap{
+			Submit: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "apply"),
+			),
+			Cancel: key.NewBinding(
+				key.WithKeys("esc"),
+				key.WithHelp("esc", "cancel"),
+			),
+		},
+		Confirm: confirmKeyMap{
+			Yes: key.NewBinding(
+				key.WithKeys("y"),
+				key.WithHelp("y", "yes"),
+			),
+			No: key.NewBinding(
+				key.WithKeys("n", "esc"),
+				key.WithHelp("n/esc", "no"),
+			),
+			Switch: key.NewBinding(
+				key.WithKeys("left", "right", "tab", "shift+tab", "h", "l"),
+				key.WithHelp("←/→", "switch button"),
+			),
+			Submit: key.NewBinding(
+				key.WithKeys("enter"),
+				key.WithHelp("enter", "choose"),
+			),
+		},
+		Error: errorKeyMap{
+			Dismiss: key.NewBinding(
+				key.WithKeys("esc", "enter"),
+				key.WithHelp("esc", "dismiss"),
+			),
+		},
+	}
+}
+
+// keyPresets are applied on top of the default key map before user overrides
+var keyPresets = map[string]map[string][]string{
+	"default": {},
+	"vim": {
+		"main.new":         {"o", "n"},
+		"main.edit":        {"i", "e"},
+		"main.delete":      {"x", "d"},
+		"main.page_up":     {"ctrl+b", "pgup"},
+		"main.page_down":   {"ctrl+f", "pgdown"},
+		"form.next":        {"tab", "ctrl+j", "down"},
+		"form.prev":        {"shift+tab", "ctrl+k", "up"},
+		"detail.back":      {"esc", "q", "h"},
+		"detail.next":      {"n", "l", "right"},
+		"detail.edit":      {"i", "e"},
+		"detail.delete":    {"x", "d"},
+		"detail.page_up":   {"ctrl+b", "pgup"},
+		"detail.page_down": {"ctrl+f", "pgdown"},
+		"board.back":       {"b", "esc", "q"},
+		"calendar.back":    {"esc", "c", "q"},
+		"agenda.table":     {"a", "esc", "tab", "q"},
+		"error.dismiss":    {"esc", "enter", "q"},
+		"palette.up":       {"up", "ctrl+k"},
+		"palette.down":     {"down", "ctrl+j"},
+		"calendar.today":   {"t", "."},
+		"main.enter":       {"enter", "l"},
+		"main.select_all":  {"ctrl+a", "V"},
+	},
+	"emacs": {
+		"global.palette":   {"alt+x"},
+		"main.up":          {"up", "ctrl+p"},
+		"main.down":        {"down", "ctrl+n"},
+		"main.page_up":     {"pgup", "alt+v"},
+		"main.page_down":   {"pgdown", "ctrl+v"},
+		"main.top":         {"home", "alt+<"},
+		"main.bottom":      {"end", "alt+>"},
+		"main.delete":      {"d", "ctrl+d"},
+		"main.clear":       {"esc", "ctrl+g"},
+		"main.undo":        {"u", "ctrl+_"},
+		"form.next":        {"tab", "down", "ctrl+n"},
+		"form.prev":        {"shift+tab", "up", "ctrl+p"},
+		"form.cancel":      {"esc", "ctrl+g"},
+		"detail.back":      {"esc", "q", "ctrl+g"},
+		"detail.up":        {"up", "ctrl+p"},
+		"detail.down":      {"down", "ctrl+n"},
+		"detail.page_up":   {"pgup", "alt+v"},
+		"detail.page_down": {"pgdown", "ctrl+v"},
+		"detail.next":      {"n", "right", "alt+n"},
+		"detail.prev":      {"N", "left", "alt+p"},
+		"detail.delete":    {"d", "ctrl+d"},
+		"trash.up":         {"up", "ctrl+p"},
+		"trash.down":       {"down", "ctrl+n"},
+		"trash.back":       {"esc", "q", "T", "ctrl+g"},
+		"matrix.up":        {"up", "ctrl+p"},
+		"matrix.down":      {"down", "ctrl+n"},
+		"matrix.left":      {"left", "ctrl+b"},
+		"matrix.right":     {"right", "ctrl+f"},
+		"matrix.back":      {"esc", "q", "E", "ctrl+g"},
+		"archive.up":       {"up", "ctrl+p"},
+		"archive.down":     {"down", "ctrl+n"},
+		"archive.back":     {"esc", "q", "Z", "ctrl+g"},
+		"time_log.up":      {"up", "ctrl+p"},
+		"time_log.down":    {"down", "ctrl+n"},
+		"time_log.back":    {"esc", "q", "t", "ctrl+g"},
+		"effort.up":        {"up", "ctrl+p"},
+		"effort.down":      {"down", "ctrl+n"},
+		"effort.back":      {"esc", "q", "R", "ctrl+g"},
+		"deferred.up":      {"up", "ctrl+p"},
+		"deferred.down":    {"down", "ctrl+n"},
+		"deferred.back":    {"esc", "q", "D", "ctrl+g"},
+		"focus.back":       {"esc", "q", "f", "ctrl+g"},
+		"confirm.no":       {"n", "esc", "ctrl+g"},
+		"board.up":         {"up", "ctrl+p"},
+		"board.down":       {"down", "ctrl+n"},
+		"board.left":       {"left", "shift+tab", "ctrl+b"},
+		"board.right":      {"right", "tab", "ctrl+f"},
+		"board.back":       {"b", "esc", "ctrl+g"},
+		"calendar.left":    {"left", "ctrl+b"},
+		"calendar.right":   {"right", "ctrl+f"},
+		"calendar.up":      {"up", "ctrl+p"},
+		"calendar.down":    {"down", "ctrl+n"},
+		"calendar.back":    {"esc", "c", "ctrl+g"},
+		"agenda.up":        {"up", "ctrl+p"},
+		"agenda.down":      {"down", "ctrl+n"},
+		"agenda.table":     {"a", "esc", "tab", "ctrl+g"},
+		"palette.up":       {"up", "ctrl+p"},
+		"palette.down":     {"down", "ctrl+n"},
+		"palette.close":    {"esc", "ctrl+g"},
+		"help.up":          {"up", "ctrl+p"},
+		"help.down":        {"down", "ctrl+n"},
+		"help.page_up":     {"pgup", "alt+v"},
+		"help.page_down":   {"pgdown", "ctrl+v"},
+		"help.close":       {"esc", "q", "ctrl+g"},
+		"prompt.cancel":    {"esc", "ctrl+g"},
+		"error.dismiss":    {"esc", "enter", "ctrl+g"},
+
+		// ctrl+p and ctrl+n edit nothing in the search field
+		"archive.search_up":   {"up", "ctrl+p"},
+		"archive.search_down": {"down", "ctrl+n"},
+	},
+}
+
+// sharedBindings are handled by the root model in views other than their own,
+// so they take part in those views' conflict checks
+var sharedBindings = map[string][]string{
+	"board":  {"main.new"},
+	"agenda": {"main.new"},
+	"matrix": {"main.new"},
+}
+
+// modeBindings only act while a view reads every other key as text, so they
+// are checked against each other and the global bindings instead of the
+// rest of their view
+var modeBindings = map[string]string{
+	"archive.search_up":   "archive.search",
+	"archive.search_down": "archive.search",
+}
+
+// KeyPresets lists the preset names accepted by NewKeyMap
+func KeyPresets() []string {
+	var names []string
+	for name := range keyPresets {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+	return names
+}
+
+// NewKeyMap builds a key map from a preset and per-binding overrides.
+// An override with no keys unbinds the action.
+func NewKeyMap(preset string, overrides map[string][]string) (KeyMap, error) {
+	if preset == "" {
+		preset = "default"
+	}
+	presetKeys, ok := keyPresets[preset]
+	if !ok {
+		return KeyMap{}, fmt.Errorf("unknown keymap preset %q (want one of %s)", preset, strings.Join(KeyPresets(), ", "))
+	}
+
+	k := DefaultKeyMap()
+	bindings := k.bindings()
+	for _, layer := range []map[string][]string{presetKeys, overrides} {
+		for id, ks := range layer {
+			b, ok := bindings[id]
+			if !ok {
+				return KeyMap{}, fmt.Errorf("unknown key binding %q", id)
+			}
+			b.SetKeys(ks...)
+			b.SetHelp(helpKeys(ks), b.Help().Desc)
+			b.SetEnabled(len(ks) > 0)
+		}
+	}
+
+	if err := k.conflicts(); err != nil {
+		return KeyMap{}, err
+	}
+	return k, nil
+}
+
+// bindings indexes every binding by its id, section and field name in snake case
+func (k *KeyMap) bindings() map[string]*key.Binding {
+	bindings := make(map[string]*key.Binding)
+	v := reflect.ValueOf(k).Elem()
+	for i := range v.NumField() {
+		section := v.Field(i)
+		prefix := snakeCase(v.Type().Field(i).Name)
+		for j := range section.NumField() {
+			id := prefix + "." + snakeCase(section.Type().Field(j).Name)
+			bindings[id] = section.Field(j).Addr().Interface().(*key.Binding)
+		}
+	}
+	return bindings
+}
+
+// conflicts reports keys bound to two actions in the same view. Global
+// bindings are checked against every view.
+func (k *KeyMap) conflicts() error {
+	bindings := k.bindings()
+	ids := make([]string, 0, len(bindings))
+	for id := range bindings {
+		ids = append(ids, id)
+	}
+	slices.Sort(ids)
+
+	contexts := make(map[string][]string)
+	var global []string
+	for _, id := range ids {
+		context, _, _ := strings.Cut(id, ".")
+		if mode, ok := modeBindings[id]; ok {
+			context = mode
+		}
+		if context == "global" {
+			global = append(global, id)
+		} else {
+			contexts[context] = append(contexts[context], id)
+		}
+	}
+
+	var problems []string
+	for context, members := range contexts {
+		members = append(members, sharedBindings[context]...)
+		members = append(members, global...)
+		owner := make(map[string]string)
+		for _, id := range members {
+			for _, s := range bindings[id].Keys() {
+				if other, ok := owner[s]; ok && other != id {
+					problems = append(problems, fmt.Sprintf("%q is bound to both %s and %s", s, other, id))
+					continue
+				}
+				owner[s] = id
+			}
+		}
+	}
+	if len(problems) == 0 {
+		return nil
+	}
+	slices.Sort(problems)
+	return fmt.Errorf("key binding conflicts:\n  %s", strings.Join(problems, "\n  "))
+}
+
+// shortHint joins the enabled bindings into a one-line "key action" hint
+func shortHint(bindings []key.Binding) string {
+	var parts []string
+	for _, b := range bindings {
+		if b.Enabled() {
+			parts = append(parts, b.Help().Key+" "+b.Help().Desc)
+		}
+	}
+	return strings.Join(parts, " • ")
+}
+
+// withDesc is b described as desc, for hints where its action reads differently
+func withDesc(b key.Binding, desc string) key.Binding {
+	b.SetHelp(b.Help().Key, desc)
+	return b
+}
+
+// helpKeys renders binding keys the way the built-in help text does
+func helpKeys(ks []string) string {
+	names := make([]string, len(ks))
+	for i, s := range ks {
+		switch s {
+		case "up":
+			s = "↑"
+		case "down":
+			s = "↓"
+		case "left":
+			s = "←"
+		case "right":
+			s = "→"
+		case " ":
+			s = "space"
+		}
+		names[i] = s
+	}
+	return strings.Join(names, "/")
+}
+
+func snakeCase(s string) string {
+	var b strings.Builder
+	for i, r := range s {
+		if unicode.IsUpper(r) {
+			if i > 0 {
+				b.WriteByte('_')
+			}
+			r = unicode.ToLower(r)
+		}
+		b.WriteRune(r)
+	}
+	return b.String()
+}
@@ -2,120 +2,222 @@ package views
 
 import (
 	"fmt"
+	"slices"
 	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/help"
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/table"
+	"github.com/charmbracelet/bubbles/textinput"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/sabry-awad97/task-manager/internal/config"
 	"github.com/sabry-awad97/task-manager/internal/tui/models"
+	"github.com/sabry-awad97/task-manager/internal/tui/zone"
 )
 
 var (
+	baseStyle            lipgloss.Style
+	mainContainerStyle   lipgloss.Style
+	titleStyle           lipgloss.Style
+	statusStyle          lipgloss.Style
+	actionStyle          lipgloss.Style
+	actionSeparatorStyle lipgloss.Style
+	tableStyles          table.Styles
+)
+
+// setMainStyles derives the task table's styles from t
+func setMainStyles(t Theme) {
 	baseStyle = lipgloss.NewStyle().
-			BorderStyle(lipgloss.RoundedBorder()).
-			BorderForeground(lipgloss.Color("240"))
+		BorderStyle(glyphs.Border).
+		BorderForeground(t.Border)
 
 	mainContainerStyle = lipgloss.NewStyle().
-				Border(lipgloss.RoundedBorder()).
-				BorderForeground(lipgloss.Color("240")).
-				Margin(0).
-				Padding(1)
+		Border(glyphs.Border).
+		BorderForeground(t.Border).
+		Margin(0).
+		Padding(1)
 
 	titleStyle = lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("205")).
-			MarginBottom(1).
-			Padding(0, 1).
-			Align(lipgloss.Center)
+		Bold(true).
+		Foreground(t.Accent).
+		MarginBottom(1).
+		Padding(0, 1).
+		Align(lipgloss.Center)
 
 	statusStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("241")).
-			Align(lipgloss.Center)
+		Foreground(t.Muted).
+		Align(lipgloss.Center)
 
 	actionStyle = lipgloss.NewStyle().
-			Foreground(lipgloss.Color("205")).
-			Width(2).
-			Align(lipgloss.Center)
+		Foreground(t.Accent).
+		Width(2).
+		Align(lipgloss.Center)
 
 	actionSeparatorStyle = lipgloss.NewStyle().
-				Foreground(lipgloss.Color("240")).
-				Width(1).
-				Align(lipgloss.Center)
-)
+		Foreground(t.Border).
+		Width(1).
+		Align(lipgloss.Center)
 
-// Add constants for action column
+	tableStyles = table.Styles{
+		Header: lipgloss.NewStyle().
+			Bold(true).
+			Foreground(t.Secondary).
+			Padding(0, 1),
+		Selected: lipgloss.NewStyle().
+			Background(t.Accent).
+			Foreground(t.OnAccent).
+			Bold(true),
+		Cell: lipgloss.NewStyle().
+			Padding(0, 1),
+	}
+}
+
+// Column titles the row renderer styles individually
 const (
-	actionViewIcon   = "👁️"
-	actionEditIcon   = "✏️"
-	actionDeleteIcon = "❌"
-	actionSeparator  = "│"
+	markColumn     = ""
+	priorityColumn = "Priority"
+	dueColumn      = "Due"
+	statusColumn   = "Status"
+	effortColumn   = "Actual/Est."
 )
 
+// tableColumn is a column the task table can show
+type tableColumn struct {
+	title string
+	width int // Fixed width, or the minimum for the title column
+	drop  int // Narrow terminals hide the highest first; 0 never hides
+	value func(row int, task models.Task, now time.Time) string
+}
+
+// tableColumns maps the config column names to their columns
+var tableColumns = map[string]tableColumn{
+	config.ColumnTitle: {
+		title: "Title",
+		width: 20,
+		value: func(_ int, t models.Task, _ time.Time) string { return t.Title },
+	},
+	config.ColumnDueDate: {
+		title: "Due Date",
+		width: 10,
+		drop:  5,
+		value: func(_ int, t models.Task, _ time.Time) string { return formatDue(t.DueDate, "2006-01-02") },
+	},
+	config.ColumnDue: {
+		title: dueColumn,
+		width: 12,
+		drop:  2,
+		value: func(_ int, t models.Task, now time.Time) string {
+			return relativeDue(models.DueDeadline(t.DueDate), now)
+		},
+	},
+	config.ColumnPriority: {
+		title: priorityColumn,
+		width: 10,
+		drop:  1,
+		value: func(_ int, t models.Task, _ time.Time) string { return priorityLabel(t.Priority) },
+	},
+	config.ColumnStatus: {
+		title: statusColumn,
+		width: 14,
+		drop:  3,
+		value: func(_ int, t models.Task, _ time.Time) string { return statusLabel(workflow.Status(t.Status)) },
+	},
+	config.ColumnEstimate: {
+		title: "Estimate",
+		width: 8,
+		drop:  6,
+		value: func(_ int, t models.Task, _ time.Time) string { return formatEstimate(t.Estimate) },
+	},
+	config.ColumnEffort: {
+		title: effortColumn,
+		width: 15,
+		drop:  7,
+		value: func(_ int, t models.Task, now time.Time) string { return formatEffort(t, now) },
+	},
+	config.ColumnChecklist: {
+		title: "Checklist",
+		width: 9,
+		drop:  8,
+		value: func(_ int, t models.Task, _ time.Time) string { return formatChecklist(t) },
+	},
+	config.ColumnActions: {
+		title: "Actions",
+		width: 12,
+		drop:  4,
+		value: func(row int, _ models.Task, _ time.Time) string { return renderActions(row) },
+	},
+}
+
+// cellPadding is the horizontal padding tableStyles adds around every cell
+const cellPadding = 2
+
 type keyMap struct {
-	Up     key.Binding
-	Down   key.Binding
-	New    key.Binding
-	Delete key.Binding
-	Edit   key.Binding
-	Help   key.Binding
-	Quit   key.Binding
-	Enter  key.Binding
-	Space  key.Binding
-}
-
-var keys = keyMap{
-	Up: key.NewBinding(
-		key.WithKeys("up", "k"),
-		key.WithHelp("↑/k", "up"),
-	),
-	Down: key.NewBinding(
-		key.WithKeys("down", "j"),
-		key.WithHelp("↓/j", "down"),
-	),
-	New: key.NewBinding(
-		key.WithKeys("n"),
-		key.WithHelp("n", "new task"),
-	),
-	Delete: key.NewBinding(
-		key.WithKeys("d"),
-		key.WithHelp("d", "delete task"),
-	),
-	Edit: key.NewBinding(
-		key.WithKeys("e"),
-		key.WithHelp("e", "edit task"),
-	),
-	Help: key.NewBinding(
-		key.WithKeys("?"),
-		key.WithHelp("?", "toggle help"),
-	),
-	Quit: key.NewBinding(
-		key.WithKeys("q", "esc"),
-		key.WithHelp("q", "quit"),
-	),
-	Enter: key.NewBinding(
-		key.WithKeys("enter"),
-		key.WithHelp("enter", "view details"),
-	),
-	Space: key.NewBinding(
-		key.WithKeys(" "),
-		key.WithHelp("space", "toggle completed"),
-	),
+	Up       key.Binding
+	Down     key.Binding
+	New      key.Binding
+	Delete   key.Binding
+	Edit     key.Binding
+	Quit     key.Binding
+	Enter    key.Binding
+	Space    key.Binding
+	Complete key.Binding
+	Board    key.Binding
+	Calendar key.Binding
+	Agenda   key.Binding
+	Trash    key.Binding
+	Archive  key.Binding
+	Matrix   key.Binding
+	Effort   key.Binding
+	Deferred key.Binding
+
+	// Archived tasks
+	ArchiveTask key.Binding
+
+	// Time tracking
+	Timer key.Binding
+	Focus key.Binding
+
+	// Selection and bulk actions
+	Visual     key.Binding
+	SelectUp   key.Binding
+	SelectDown key.Binding
+	Mark       key.Binding
+	SelectAll  key.Binding
+	Clear      key.Binding
+	Priority   key.Binding
+	Tag        key.Binding
+	Reschedule key.Binding
+	Snooze     key.Binding
+	Undo       key.Binding
+
+	// Scrolling
+	PageUp   key.Binding
+	PageDown key.Binding
+	Top      key.Binding
+	Bottom   key.Binding
+
+	// Preview pane
+	Preview       key.Binding
+	PreviewGrow   key.Binding
+	PreviewShrink key.Binding
 }
 
 // Add these methods after the keyMap struct definition
 func (k keyMap) ShortHelp() []key.Binding {
-	return []key.Binding{k.Help, k.Quit}
+	return []key.Binding{k.Enter, k.New, k.Quit}
 }
 
 func (k keyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
-		{k.Up, k.Down, k.Enter},
-		{k.New, k.Edit, k.Space},
-		{k.Delete, k.Help},
-		{k.Quit},
+		{k.Up, k.Down, k.PageUp, k.PageDown, k.Top, k.Bottom, k.Enter},
+		{k.New, k.Edit, k.Space, k.Complete, k.Delete, k.Timer, k.Focus},
+		{k.Visual, k.SelectUp, k.SelectDown, k.Mark, k.SelectAll, k.Clear},
+		{k.Priority, k.Tag, k.Reschedule, k.Snooze, k.ArchiveTask, k.Undo},
+		{k.Board, k.Calendar, k.Agenda, k.Matrix, k.Effort, k.Deferred, k.Trash, k.Archive, k.Preview, k.PreviewGrow, k.PreviewShrink, k.Quit},
 	}
 }
 
@@ -124,10 +226,22 @@ type ShowDetailMsg struct {
 	Task models.Task
 }
 
+// AdvanceTaskMsg moves a task to the next state of its workflow
+type AdvanceTaskMsg struct {
+	TaskID string
+}
+
+// ToggleTaskMsg completes a task, or reopens it when it is done
 type ToggleTaskMsg struct {
 	TaskID string
 }
 
+// SetStatusMsg moves a task to a given workflow state
+type SetStatusMsg struct {
+	TaskID string
+	Status string
+}
+
 // Add edit message type
 type EditTaskMsg struct {
 	Task models.Task
@@ -139,47 +253,68 @@ type DeleteTaskMsg struct {
 }
 
 type MainViewModel struct {
-	table    table.Model
-	tasks    []models.Task
-	help     help.Model
-	showHelp bool
-	width    int
-	height   int
-	mouseX   int // Add mouse position tracking
-	mouseY   int // Add mouse position tracking
+	table     table.Model
+	tasks     []models.Task
+	help      help.Model
+	highlight config.Highlight
+	offset    int      // First table row on screen
+	columns   []string // Configured columns, in order
+	visible   []string // Columns that fit the current width
+
+	// Preview pane beside the table on wide terminals
+	preview      bool
+	previewWidth int  // Pane width set by resizing; 0 uses the default
+	resizing     bool // The divider is being dragged
+
+	// Multi-select state
+	marked map[string]bool
+	visual bool
+	anchor int // Row where the visual range started
+
+	// Bulk action argument prompt
+	prompting    bool
+	promptAction BulkAction
+	prompt       textinput.Model
+	promptErr    string
+
+	width  int
+	height int
 }
 
-func NewMainViewModel() MainViewModel {
-	columns := []table.Column{
-		{Title: "Title", Width: 30},
-		{Title: "Due Date", Width: 12},
-		{Title: "Priority", Width: 12},
-		{Title: "Status", Width: 10},
-		{Title: "Actions", Width: 25}, // Increased width for actions
-	}
-
+// NewMainViewModel builds the task table with columns, a list of
+// config.Column names in display order
+func NewMainViewModel(highlight config.Highlight, columns []string) MainViewModel {
 	t := table.New(
-		table.WithColumns(columns),
 		table.WithFocused(true),
 		table.WithHeight(10),
 	)
 
-	t.SetStyles(table.Styles{
-		Header: lipgloss.NewStyle().
-			Bold(true).
-			Foreground(lipgloss.Color("99")).
-			Padding(0, 1),
-		Selected: lipgloss.NewStyle().
-			Background(lipgloss.Color("205")).
-			Foreground(lipgloss.Color("0")).
-			Bold(true),
-		Cell: lipgloss.NewStyle().
-			Padding(0, 1),
-	})
+	t.SetStyles(tableStyles)
+	t.KeyMap = tableKeys()
+
+	m := MainViewModel{
+		table:     t,
+		help:      help.New(),
+		highlight: highlight,
+		columns:   columns,
+		preview:   true,
+		marked:    make(map[string]bool),
+	}
+	m.layoutColumns()
+	return m
+}
 
-	return MainViewModel{
-		table: t,
-		help:  help.New(),
+// tableKeys drives the table's own cursor movement from the live key map
+func tableKeys() table.KeyMap {
+	return table.KeyMap{
+		LineUp:       keys.Main.Up,
+		LineDown:     keys.Main.Down,
+		PageUp:       keys.Main.PageUp,
+		PageDown:     keys.Main.PageDown,
+		HalfPageUp:   key.NewBinding(key.WithDisabled()),
+		HalfPageDown: key.NewBinding(key.WithDisabled()),
+		GotoTop:      keys.Main.Top,
+		GotoBottom:   keys.Main.Bottom,
 	}
 }
 
@@ -193,109 +328,240 @@ func (m MainViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 	case tea.WindowSizeMsg:
 		m.width = msg.Width
 		m.height = msg.Height
-		m.table.SetWidth(m.width)
-		m.table.SetHeight(m.height - 6)
+		// Leave room for the title, its margin and the status line
+		m.table.SetHeight(m.height - 9)
+		m.layoutColumns()
+		m.syncOffset()
 		return m, nil
 
 	case tea.KeyMsg:
+		if m.prompting {
+			return m.updatePrompt(msg)
+		}
+
 		switch {
-		case key.Matches(msg, keys.Help):
-			m.showHelp = !m.showHelp
+		case key.Matches(msg, keys.Main.Visual):
+			if m.visual {
+				m.commitVisual()
+			} else {
+				m.visual = true
+				m.anchor = m.table.Cursor()
+			}
+			return m, nil
+		case key.Matches(msg, keys.Main.SelectUp, keys.Main.SelectDown):
+			if !m.visual {
+				m.visual = true
+				m.anchor = m.table.Cursor()
+			}
+			if key.Matches(msg, keys.Main.SelectUp) {
+				m.table.MoveUp(1)
+			} else {
+				m.table.MoveDown(1)
+			}
+			m.syncOffset()
+			return m, nil
+		case key.Matches(msg, keys.Main.Mark):
+			if task, ok := m.SelectedTask(); ok {
+				if m.marked[task.ID] {
+					delete(m.marked, task.ID)
+				} else {
+					m.marked[task.ID] = true
+				}
+				m.table.MoveDown(1)
+				m.syncOffset()
+			}
+			return m, nil
+		case key.Matches(msg, keys.Main.SelectAll):
+			for _, task := range m.tasks {
+				m.marked[task.ID] = true
+			}
+			return m, nil
+		case key.Matches(msg, keys.Main.Clear):
+			m.clearSelection()
 			return m, nil
-		case msg.Type == tea.KeyEnter:
+		case key.Matches(msg, keys.Main.Priority):
+			m.openPrompt(BulkSetPriority)
+			return m, textinput.Blink
+		case key.Matches(msg, keys.Main.Tag):
+			m.openPrompt(BulkAddTag)
+			return m, textinput.Blink
+		case key.Matches(msg, keys.Main.Reschedule):
+			m.openPrompt(BulkReschedule)
+			return m, textinput.Blink
+		case key.Matches(msg, keys.Main.Snooze):
+			m.openPrompt(BulkSnooze)
+			return m, textinput.Blink
+		case key.Matches(msg, keys.Main.Undo):
+			return m, func() tea.Msg { return UndoMsg{} }
+		case key.Matches(msg, keys.Main.Preview):
+			m.preview = !m.preview
+			m.layoutColumns()
+			return m, nil
+		case key.Matches(msg, keys.Main.PreviewGrow):
+			m.resizePreview(m.previewPaneWidth() + previewStep)
+			return m, nil
+		case key.Matches(msg, keys.Main.PreviewShrink):
+			m.resizePreview(m.previewPaneWidth() - previewStep)
+			return m, nil
+		case key.Matches(msg, keys.Main.Enter):
 			if task, ok := m.SelectedTask(); ok {
 				return m, func() tea.Msg {
 					return ShowDetailMsg{Task: task}
 				}
 			}
-		case key.Matches(msg, keys.Space):
+		case key.Matches(msg, keys.Main.Space):
+			if cmd, ok := m.bulkCmd(BulkAdvance); ok {
+				return m, cmd
+			}
 			if task, ok := m.SelectedTask(); ok {
 				return m, func() tea.Msg {
-					return ToggleTaskMsg{TaskID: task.ID}
+					return AdvanceTaskMsg{TaskID: task.ID}
 				}
 			}
-		case key.Matches(msg, keys.Edit):
+		case key.Matches(msg, keys.Main.Complete):
+			if cmd, ok := m.bulkCmd(BulkComplete); ok {
+				return m, cmd
+			}
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return CompleteTaskMsg{TaskID: task.ID}
+				}
+			}
+		case key.Matches(msg, keys.Main.Edit):
 			if task, ok := m.SelectedTask(); ok {
 				return m, func() tea.Msg {
 					return EditTaskMsg{Task: task}
 				}
 			}
-		case key.Matches(msg, keys.Delete):
+		case key.Matches(msg, keys.Main.Delete):
+			if cmd, ok := m.bulkCmd(BulkDelete); ok {
+				return m, cmd
+			}
 			if task, ok := m.SelectedTask(); ok {
 				return m, func() tea.Msg {
 					return DeleteTaskMsg{TaskID: task.ID}
 				}
 			}
+		case key.Matches(msg, keys.Main.Timer):
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return ToggleTimerMsg{TaskID: task.ID}
+				}
+			}
+		case key.Matches(msg, keys.Main.Focus):
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return ShowFocusMsg{Task: task}
+				}
+			}
+		case key.Matches(msg, keys.Main.ArchiveTask):
+			if ids := m.targetIDs(); len(ids) > 0 {
+				m.clearSelection()
+				archive := ArchiveTasksMsg{TaskIDs: ids}
+				open := 0
+				for _, task := range m.tasks {
+					if !task.Completed && slices.Contains(ids, task.ID) {
+						open++
+					}
+				}
+				if open == 0 {
+					return m, func() tea.Msg { return archive }
+				}
+				// Unfinished tasks leave the table too, so ask first
+				return m, func() tea.Msg {
+					return ConfirmMsg{
+						Title:   "Archive",
+						Prompt:  fmt.Sprintf("Archive %d of %d tasks that are not done yet?", open, len(ids)),
+						Confirm: archive,
+					}
+				}
+			}
 		}
 
 	case tea.MouseMsg:
-		m.mouseX = msg.X
-		m.mouseY = msg.Y
-
-		switch msg.Action {
-		case tea.MouseActionPress:
-			if msg.Button == tea.MouseButtonLeft {
-				if m.isClickInTable(msg) {
-					rowIdx := m.getClickedRowIndex(msg)
-					if rowIdx >= 0 && rowIdx < len(m.tasks) {
-						m.table.SetCursor(rowIdx)
-
-						// Check for action column clicks
-						if clickedAction := m.getClickedAction(msg); clickedAction != nil {
-							task, ok := m.SelectedTask()
-							if ok {
-								msg := clickedAction(task)
-								return m, func() tea.Msg { return msg }
-							}
-						}
-					}
-				}
+		if m.updateDivider(msg) {
+			return m, nil
+		}
+		if msg.Action != tea.MouseActionPress {
+			break
+		}
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.table.MoveUp(wheelStep)
+			m.syncOffset()
+			return m, nil
+		case tea.MouseButtonWheelDown:
+			m.table.MoveDown(wheelStep)
+			m.syncOffset()
+			return m, nil
+		case tea.MouseButtonLeft:
+			row, ok := m.rowAt(msg)
+			if !ok {
+				break
+			}
+			m.table.SetCursor(row)
+			m.syncOffset()
+			if action := m.actionAt(msg, row); action != nil {
+				msg := action(m.tasks[row])
+				return m, func() tea.Msg { return msg }
 			}
+			return m, nil
 		}
 	}
 
 	m.table, cmd = m.table.Update(msg)
+	m.syncOffset()
 	return m, cmd
 }
 
-// Add helper methods for mouse interaction
-func (m MainViewModel) isClickInTable(msg tea.MouseMsg) bool {
-	// Adjust these values based on your layout
-	tableTop := 2 // Account for title and padding
-	tableBottom := tableTop + m.table.Height()
-
-	return msg.Y >= tableTop && msg.Y <= tableBottom
+// syncOffset scrolls the rendered window so the cursor row stays visible
+func (m *MainViewModel) syncOffset() {
+	height := max(m.table.Height(), 1)
+	cursor := m.table.Cursor()
+	switch {
+	case cursor < m.offset:
+		m.offset = cursor
+	case cursor >= m.offset+height:
+		m.offset = cursor - height + 1
+	}
+	m.offset = max(min(m.offset, len(m.tasks)-height), 0)
 }
 
-func (m MainViewModel) getClickedRowIndex(msg tea.MouseMsg) int {
-	tableTop := 2               // Same as in isClickInTablesClickInTable
-	return msg.Y - tableTop - 1 // -1 for header row
+// wheelStep is how many rows one scroll wheel notch moves the cursor
+const wheelStep = 3
+
+// rowZone and actionZone name the clickable regions of a table row
+func rowZone(row int) string {
+	return fmt.Sprintf("main.row.%d", row)
 }
 
-// Add helper method for action column clicks
-func (m MainViewModel) getClickedAction(msg tea.MouseMsg) func(models.Task) tea.Msg {
-	// Calculate action column start (adjust these values based on your layout)
-	actionsStart := m.width - 24 // Adjusted for new column width
+func actionZone(action string, row int) string {
+	return fmt.Sprintf("main.%s.%d", action, row)
+}
 
-	// If click is not in actions column
-	if msg.X < actionsStart {
-		return nil
+// rowAt returns the table row under the mouse
+func (m MainViewModel) rowAt(msg tea.MouseMsg) (int, bool) {
+	end := min(m.offset+m.table.Height(), len(m.tasks))
+	for r := m.offset; r < end; r++ {
+		if zone.Get(rowZone(r)).InBounds(msg) {
+			return r, true
+		}
 	}
+	return 0, false
+}
 
-	// Calculate relative position within actions section
-	relativeX := msg.X - actionsStart
-
-	// Define click regions for each action
+// actionAt returns the action icon under the mouse in row, if any
+func (m MainViewModel) actionAt(msg tea.MouseMsg, row int) func(models.Task) tea.Msg {
 	switch {
-	case relativeX >= 0 && relativeX < 4: // View icon
+	case zone.Get(actionZone("view", row)).InBounds(msg):
 		return func(t models.Task) tea.Msg {
 			return ShowDetailMsg{Task: t}
 		}
-	case relativeX >= 7 && relativeX < 11: // Edit icon
+	case zone.Get(actionZone("edit", row)).InBounds(msg):
 		return func(t models.Task) tea.Msg {
 			return EditTaskMsg{Task: t}
 		}
-	case relativeX >= 14 && relativeX < 18: // Delete icon
+	case zone.Get(actionZone("delete", row)).InBounds(msg):
 		return func(t models.Task) tea.Msg {
 			return DeleteTaskMsg{TaskID: t.ID}
 		}
@@ -305,8 +571,8 @@ func (m MainViewModel) getClickedAction(msg tea.MouseMsg) func(models.Task) tea.
 }
 
 func (m MainViewModel) View() string {
-	if m.showHelp {
-		return RenderHelpModal(m.width, m.height)
+	if display.ScreenReader {
+		return m.renderLinear()
 	}
 
 	// Pre-allocate builders with estimated capacity
@@ -314,78 +580,343 @@ func (m MainViewModel) View() string {
 	content.Grow(m.width * m.height)
 
 	// Build content in single pass
-	content.WriteString(titleStyle.Render("✨ Task Manager ✨"))
+	title := "Task Manager"
+	if glyphs.App != "" {
+		title = glyphs.App + " " + title + " " + glyphs.App
+	}
+	content.WriteString(titleStyle.Render(title))
 	content.WriteByte('\n')
-	content.WriteString(m.table.View())
+	content.WriteString(m.renderTable())
 	content.WriteByte('\n')
-	content.WriteString(statusStyle.Render(fmt.Sprintf("%d tasks • Press ? for help", len(m.tasks))))
+	content.WriteString(m.renderStatus())
+
+	body := content.String()
+	if m.showPreview() {
+		// Content height inside both borders and the container padding
+		height := m.height - 6
+		table := lipgloss.NewStyle().Width(m.tableWidth()).Height(height).Render(body)
+		body = lipgloss.JoinHorizontal(lipgloss.Top, table, m.renderDivider(height), m.renderPreview(height))
+	}
 
-	// Apply container styles in sequence
+	// Apply container styles in sequence. Widths exclude the borders.
 	return baseStyle.
-		Width(m.width).
-		Height(m.height).
+		Width(m.width - 2).
+		Height(m.height - 2).
 		Render(
 			mainContainerStyle.
 				Width(m.width - 4).
-				Height(m.height - 2).
-				Render(content.String()),
+				Height(m.height - 4).
+				Render(body),
 		)
 }
 
+// contentWidth is the width inside both borders and the container padding
+func (m MainViewModel) contentWidth() int {
+	return m.width - 6
+}
+
+// layoutColumns fits the configured columns to the width, hiding the
+// lowest-priority ones first and giving the title what is left
+func (m *MainViewModel) layoutColumns() {
+	avail := m.tableWidth()
+	visible := slices.Clone(m.columns)
+	used := columnsWidth(visible)
+	for m.width > 0 && used > avail {
+		drop := -1
+		for i, name := range visible {
+			if c := tableColumns[name]; c.drop > 0 && (drop < 0 || c.drop > tableColumns[visible[drop]].drop) {
+				drop = i
+			}
+		}
+		if drop < 0 {
+			break
+		}
+		visible = slices.Delete(visible, drop, drop+1)
+		used = columnsWidth(visible)
+	}
+
+	columns := []table.Column{{Title: markColumn, Width: markWidth()}}
+	for _, name := range visible {
+		c := tableColumns[name]
+		width := c.width
+		if name == config.ColumnTitle && m.width > 0 {
+			width = max(c.width+avail-used, 1)
+		}
+		columns = append(columns, table.Column{Title: c.title, Width: width})
+	}
+
+	m.visible = visible
+	m.table.SetWidth(avail)
+	// Clear the rows first so the table never holds rows of the old layout
+	m.table.SetRows(nil)
+	m.table.SetColumns(columns)
+	m.refreshRows()
+}
+
+// columnsWidth is the rendered width of the mark column plus the named columns
+func columnsWidth(names []string) int {
+	width := markWidth() + cellPadding
+	for _, name := range names {
+		width += tableColumns[name].width + cellPadding
+	}
+	return width
+}
+
+// renderActions draws a row's clickable action icons
+func renderActions(row int) string {
+	actions := []string{
+		zone.Mark(actionZone("view", row), actionStyle.Render(glyphs.ViewAction)),
+		actionSeparatorStyle.Render(glyphs.ActionSeparator),
+		zone.Mark(actionZone("edit", row), actionStyle.Render(glyphs.EditAction)),
+		actionSeparatorStyle.Render(glyphs.ActionSeparator),
+		zone.Mark(actionZone("delete", row), actionStyle.Render(glyphs.DeleteAction)),
+	}
+	return strings.Join(actions, " ")
+}
+
 func (m *MainViewModel) UpdateTasks(tasks []models.Task) {
 	m.tasks = tasks
-	rows := make([]table.Row, len(tasks))
 
 	sort.Slice(tasks, func(i, j int) bool {
 		return tasks[i].DueDate.Before(tasks[j].DueDate)
 	})
 
-	for i, task := range tasks {
-		priorityStyle := lipgloss.NewStyle()
-		switch task.Priority {
-		case models.Low:
-			priorityStyle = priorityStyle.Foreground(lipgloss.Color("42"))
-		case models.Medium:
-			priorityStyle = priorityStyle.Foreground(lipgloss.Color("214"))
-		case models.High:
-			priorityStyle = priorityStyle.Foreground(lipgloss.Color("196"))
-		}
+	m.refreshRows()
+	m.syncOffset()
 
-		status := "Pending"
-		if task.Completed {
-			status = "Done"
+	// Drop marks for tasks that no longer exist
+	present := make(map[string]bool, len(tasks))
+	for _, task := range tasks {
+		present[task.ID] = true
+	}
+	for id := range m.marked {
+		if !present[id] {
+			delete(m.marked, id)
 		}
+	}
+}
 
-		// Render actions with fixed widths and proper spacing
-		actions := []string{
-			actionStyle.Render(actionViewIcon),
-			actionSeparatorStyle.Render(actionSeparator),
-			actionStyle.Render(actionEditIcon),
-			actionSeparatorStyle.Render(actionSeparator),
-			actionStyle.Render(actionDeleteIcon),
+// refreshRows renders the cell values of the visible columns for every task
+func (m *MainViewModel) refreshRows() {
+	now := time.Now()
+	rows := make([]table.Row, len(m.tasks))
+	for i, task := range m.tasks {
+		row := table.Row{""}
+		for _, name := range m.visible {
+			row = append(row, tableColumns[name].value(i, task, now))
 		}
+		rows[i] = row
+	}
+	m.table.SetRows(rows)
+}
 
-		rows[i] = table.Row{
-			task.Title,
-			task.DueDate.Format("2006-01-02"),
-			priorityStyle.Render(task.Priority.String()),
-			status,
-			strings.Join(actions, " "), // Add space between elements
+func (m MainViewModel) renderStatus() string {
+	if m.prompting {
+		status := m.prompt.View()
+		if m.promptErr != "" {
+			status += "  " + errorStyle.Render(m.promptErr)
 		}
+		return status
 	}
 
-	m.table.SetRows(rows)
+	status := fmt.Sprintf("%d tasks • %s", len(m.tasks), shortHint([]key.Binding{keys.Global.Help}))
+	if task, start, ok := runningTimer(m.tasks); ok {
+		status = withIcon(glyphs.Timer, fmt.Sprintf("%s %s", task.Title, formatClock(time.Since(start)))) + " • " + status
+	}
+	if n := m.markedCount(); n > 0 || m.visual {
+		status = fmt.Sprintf("%d selected • %s", n, shortHint([]key.Binding{
+			keys.Main.Space, keys.Main.Complete, keys.Main.Delete, keys.Main.Priority, keys.Main.Tag, keys.Main.Reschedule, keys.Main.Clear,
+		}))
+		if m.visual {
+			status = "-- VISUAL -- " + status
+		}
+	}
+	return statusStyle.Render(status)
 }
 
 func (m MainViewModel) SelectedTask() (models.Task, bool) {
-	if len(m.tasks) == 0 {
+	cursor := m.table.Cursor()
+	if cursor < 0 || cursor >= len(m.tasks) {
 		return models.Task{}, false
 	}
-	selected := m.table.SelectedRow()
-	for _, task := range m.tasks {
-		if task.Title == selected[0] {
-			return task, true
+	return m.tasks[cursor], true
+}
+
+// renderTable draws the header and visible rows. Rows are rendered here rather
+// than by table.Model because its cell truncation counts escape codes, which
+// cuts styled cells short.
+func (m MainViewModel) renderTable() string {
+	columns := m.table.Columns()
+	rows := m.table.Rows()
+
+	headers := make([]string, len(columns))
+	for i, col := range columns {
+		headers[i] = tableStyles.Header.Render(fitCell(col.Title, col.Width))
+	}
+
+	lines := []string{lipgloss.JoinHorizontal(lipgloss.Top, headers...)}
+	end := min(m.offset+m.table.Height(), len(rows))
+	for r := m.offset; r < end; r++ {
+		rowStyle := m.rowStyle(m.tasks[r])
+		selected := r == m.table.Cursor()
+		marked := m.isMarked(r)
+
+		cells := make([]string, len(columns))
+		for i, col := range columns {
+			style := tableStyles.Cell.Inherit(rowStyle)
+			if col.Title == priorityColumn && !m.isHighlighted(m.tasks[r]) {
+				style = style.Foreground(theme.PriorityColor(m.tasks[r].Priority))
+			}
+			if col.Title == statusColumn {
+				style = style.Foreground(theme.StatusColor(workflow.Status(m.tasks[r].Status)))
+			}
+			if col.Title == effortColumn && overEstimate(m.tasks[r], time.Now()) && !m.isHighlighted(m.tasks[r]) {
+				style = style.Foreground(theme.Danger)
+			}
+			value := rows[r][i]
+			if col.Title == markColumn {
+				value = m.markCell(selected, marked)
+			}
+			if marked {
+				style = style.Inherit(markedRowStyle)
+			}
+			if selected {
+				style = style.Inherit(tableStyles.Selected).
+					Foreground(tableStyles.Selected.GetForeground()).
+					Background(tableStyles.Selected.GetBackground())
+			}
+			cells[i] = style.Render(fitCell(value, col.Width))
+		}
+		lines = append(lines, zone.Mark(rowZone(r), lipgloss.JoinHorizontal(lipgloss.Top, cells...)))
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// rowStyle marks overdue, due-soon and completed rows
+func (m MainViewModel) rowStyle(task models.Task) lipgloss.Style {
+	style := lipgloss.NewStyle()
+	now := time.Now()
+	switch {
+	case task.Completed:
+		style = style.Foreground(colorOr(m.highlight.CompletedColor, theme.Muted)).
+			Strikethrough(m.highlight.StrikeCompleted)
+	case task.DueBefore(now):
+		style = style.Foreground(colorOr(m.highlight.OverdueColor, theme.Danger))
+	case task.DueBefore(now.Add(m.highlight.DueSoon)):
+		style = style.Foreground(colorOr(m.highlight.DueSoonColor, theme.Warning))
+	}
+	return style
+}
+
+func (m MainViewModel) isHighlighted(task models.Task) bool {
+	return task.Completed || task.DueBefore(time.Now().Add(m.highlight.DueSoon))
+}
+
+// renderLinear lists the tasks one sentence per line for screen readers,
+// without borders or columns
+func (m MainViewModel) renderLinear() string {
+	var b strings.Builder
+	b.WriteString("Task Manager\n")
+	if len(m.tasks) == 0 {
+		b.WriteString("No tasks.\n")
+	} else {
+		fmt.Fprintf(&b, "Task %d of %d selected.\n", m.table.Cursor()+1, len(m.tasks))
+	}
+
+	now := time.Now()
+	end := min(m.offset+m.table.Height(), len(m.tasks))
+	for i := m.offset; i < end; i++ {
+		task := m.tasks[i]
+		prefix := "  "
+		if i == m.table.Cursor() {
+			prefix = cursorMarker + " "
+		}
+		status := strings.ToLower(workflow.Status(task.Status).Name)
+		due := "No due date"
+		if !task.DueDate.IsZero() {
+			due = fmt.Sprintf("Due %s, %s", task.DueDate.Format("2006-01-02"), relativeDue(models.DueDeadline(task.DueDate), now))
 		}
+		fmt.Fprintf(&b, "%s%d. %s. %s. Priority %s. Status %s.",
+			prefix, i+1, task.Title, due, strings.ToLower(task.Priority.String()), status)
+		if m.isMarked(i) {
+			b.WriteString(" Marked.")
+		}
+		b.WriteString("\n")
+	}
+
+	b.WriteString(m.renderStatus())
+	return b.String()
+}
+
+// markWidth fits the mark column to the cursor marker as well when markers are on
+func markWidth() int {
+	if glyphs.Markers {
+		return 2
+	}
+	return 1
+}
+
+// markCell shows marked rows, and the cursor when colour alone cannot
+func (m MainViewModel) markCell(selected, marked bool) string {
+	cell := ""
+	if glyphs.Markers && selected {
+		cell = cursorMarker
+	}
+	if marked {
+		cell += glyphs.Mark
+	}
+	return cell
+}
+
+// fitCell pads or truncates s to exactly width cells, adding an ellipsis when cut
+func fitCell(s string, width int) string {
+	return lipgloss.NewStyle().
+		Width(width).
+		MaxWidth(width).
+		Inline(true).
+		Render(ansi.Truncate(s, width, glyphs.Ellipsis))
+}
+
+// relativeDue describes a due date relative to now, such as "in 2d" or "3d
+// overdue", or nothing for a zero due date
+func relativeDue(due, now time.Time) string {
+	if due.IsZero() {
+		return ""
+	}
+	d := due.Sub(now)
+	overdue := d < 0
+	if overdue {
+		d = -d
+	}
+
+	if d < time.Minute {
+		return "now"
+	}
+	amount := shortDuration(d)
+
+	if overdue {
+		return amount + " overdue"
+	}
+	return "in " + amount
+}
+
+// formatDue writes a due date in layout, or nothing for a task without one
+func formatDue(t time.Time, layout string) string {
+	if t.IsZero() {
+		return ""
+	}
+	return t.Format(layout)
+}
+
+// shortDuration renders d in its largest whole unit, such as "45m", "3h" or "2d"
+func shortDuration(d time.Duration) string {
+	switch {
+	case d < time.Hour:
+		return fmt.Sprintf("%dm", int(d.Minutes()))
+	case d < 24*time.Hour:
+		return fmt.Sprintf("%dh", int(d.Hours()))
+	default:
+		return fmt.Sprintf("%dd", int(d.Hours()/24))
 	}
-	return models.Task{}, false
 }
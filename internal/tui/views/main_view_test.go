@@ -0,0 +1,58 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sabry-awad97/task-manager/internal/config"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+func TestDueTodayIsNotOverdue(t *testing.T) {
+	for _, name := range []string{"America/New_York", "UTC", "Asia/Tokyo"} {
+		t.Run(name, func(t *testing.T) {
+			inLocation(t, name)
+
+			y, mo, d := time.Now().Date()
+			today := time.Date(y, mo, d, 0, 0, 0, 0, time.UTC)
+			for _, hour := range []int{0, 9, 23} {
+				now := time.Date(y, mo, d, hour, 30, 0, 0, time.Local)
+				deadline := models.DueDeadline(today)
+				if deadline.Before(now) {
+					t.Errorf("at %02d:30 a task due today is overdue", hour)
+				}
+				if got := relativeDue(deadline, now); got != "in "+shortDuration(deadline.Sub(now)) {
+					t.Errorf("at %02d:30 relativeDue = %q", hour, got)
+				}
+				if yesterday := models.DueDeadline(today.AddDate(0, 0, -1)); !yesterday.Before(now) {
+					t.Errorf("at %02d:30 a task due yesterday is not overdue", hour)
+				}
+			}
+		})
+	}
+}
+
+func TestUndatedTaskIsNeitherDueNorOverdue(t *testing.T) {
+	task := models.NewTask("a", "", time.Time{}, models.Low)
+	now := time.Now()
+	if task.DueBefore(now.AddDate(100, 0, 0)) {
+		t.Error("an undated task falls due")
+	}
+	if got := relativeDue(models.DueDeadline(task.DueDate), now); got != "" {
+		t.Errorf("relativeDue = %q, want nothing", got)
+	}
+	for _, name := range []string{config.ColumnDue, config.ColumnDueDate} {
+		if got := tableColumns[name].value(0, task, now); got != "" {
+			t.Errorf("%s column = %q, want nothing", name, got)
+		}
+	}
+
+	m := NewMainViewModel(config.Highlight{DueSoon: 48 * time.Hour}, nil)
+	if m.isHighlighted(task) {
+		t.Error("an undated task is highlighted")
+	}
+	if got := m.rowStyle(task).GetForeground(); got != (lipgloss.NoColor{}) {
+		t.Errorf("an undated row is coloured %v", got)
+	}
+}
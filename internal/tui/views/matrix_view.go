@@ -0,0 +1,393 @@
+package views
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/charmbracelet/x/ansi"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+	"github.com/sabry-awad97/task-manager/internal/tui/zone"
+)
+
+var (
+	quadrantStyle         lipgloss.Style
+	activeQuadrantStyle   lipgloss.Style
+	quadrantTitleStyle    lipgloss.Style
+	quadrantHintStyle     lipgloss.Style
+	matrixAxisStyle       lipgloss.Style
+	matrixSelectedStyle   lipgloss.Style
+	matrixEmptyStyle      lipgloss.Style
+	matrixUrgentDueStyle  lipgloss.Style
+	matrixRelaxedDueStyle lipgloss.Style
+)
+
+// setMatrixStyles derives the Eisenhower matrix's styles from t
+func setMatrixStyles(t Theme) {
+	quadrantStyle = lipgloss.NewStyle().
+		Border(glyphs.Border).
+		BorderForeground(t.Border).
+		Padding(0, 1)
+
+	activeQuadrantStyle = quadrantStyle.
+		BorderForeground(t.Secondary)
+
+	quadrantTitleStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Secondary)
+
+	quadrantHintStyle = lipgloss.NewStyle().
+		Foreground(t.Muted).
+		MarginBottom(1)
+
+	matrixAxisStyle = lipgloss.NewStyle().
+		Bold(true).
+		Foreground(t.Muted).
+		Align(lipgloss.Center)
+
+	matrixSelectedStyle = lipgloss.NewStyle().
+		Background(t.Accent).
+		Foreground(t.OnAccent)
+
+	matrixEmptyStyle = lipgloss.NewStyle().
+		Foreground(t.Muted).
+		Italic(true)
+
+	matrixUrgentDueStyle = lipgloss.NewStyle().
+		Foreground(t.Danger)
+
+	matrixRelaxedDueStyle = lipgloss.NewStyle().
+		Foreground(t.Muted)
+}
+
+type matrixKeyMap struct {
+	Up    key.Binding
+	Down  key.Binding
+	Left  key.Binding
+	Right key.Binding
+	Enter key.Binding
+	Space key.Binding
+	Back  key.Binding
+}
+
+func (k matrixKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Enter, k.Space, k.Back}
+}
+
+func (k matrixKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down, k.Left, k.Right, k.Enter},
+		{k.Space, keys.Main.New},
+		{k.Back},
+	}
+}
+
+// Quadrants of the matrix, row by row: important on top, urgent on the left
+const (
+	quadrantDo = iota
+	quadrantSchedule
+	quadrantDelegate
+	quadrantEliminate
+	quadrantCount
+)
+
+// matrixQuadrant is one cell of the matrix and the open tasks placed in it
+type matrixQuadrant struct {
+	title string
+	hint  string
+	tasks []models.Task
+}
+
+// MatrixViewModel sorts open tasks into an Eisenhower matrix. Importance
+// comes from the priority and urgency from the due date.
+type MatrixViewModel struct {
+	quadrants    [quadrantCount]matrixQuadrant
+	quadrant     int
+	cursor       [quadrantCount]int
+	important    models.PriorityLevel // Lowest priority that counts as important
+	urgentWithin time.Duration        // Tasks due sooner than this are urgent
+	width        int
+	height       int
+	shouldReturn bool
+}
+
+func NewMatrixViewModel(important models.PriorityLevel, urgentWithin time.Duration) MatrixViewModel {
+	return MatrixViewModel{
+		quadrants: [quadrantCount]matrixQuadrant{
+			quadrantDo:        {title: "Do first", hint: "urgent, important"},
+			quadrantSchedule:  {title: "Schedule", hint: "not urgent, important"},
+			quadrantDelegate:  {title: "Delegate", hint: "urgent, not important"},
+			quadrantEliminate: {title: "Eliminate", hint: "not urgent, not important"},
+		},
+		important:    important,
+		urgentWithin: urgentWithin,
+	}
+}
+
+func (m MatrixViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m MatrixViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Matrix.Back):
+			m.shouldReturn = true
+		case key.Matches(msg, keys.Matrix.Up):
+			m.moveUp()
+		case key.Matches(msg, keys.Matrix.Down):
+			m.moveDown()
+		case key.Matches(msg, keys.Matrix.Left):
+			if m.quadrant%2 == 1 {
+				m.quadrant--
+			}
+		case key.Matches(msg, keys.Matrix.Right):
+			if m.quadrant%2 == 0 {
+				m.quadrant++
+			}
+		case key.Matches(msg, keys.Matrix.Enter):
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return ShowDetailMsg{Task: task}
+				}
+			}
+		case key.Matches(msg, keys.Matrix.Space):
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return AdvanceTaskMsg{TaskID: task.ID}
+				}
+			}
+		}
+
+	case tea.MouseMsg:
+		if msg.Action != tea.MouseActionPress {
+			break
+		}
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.cursor[m.quadrant] = max(m.cursor[m.quadrant]-wheelStep, 0)
+		case tea.MouseButtonWheelDown:
+			last := len(m.quadrants[m.quadrant].tasks) - 1
+			m.cursor[m.quadrant] = max(min(m.cursor[m.quadrant]+wheelStep, last), 0)
+		case tea.MouseButtonLeft:
+			for q := range quadrantCount {
+				if !zone.Get(quadrantZone(q)).InBounds(msg) {
+					continue
+				}
+				m.quadrant = q
+				start, end := m.window(q)
+				for i := start; i < end; i++ {
+					if zone.Get(matrixItemZone(q, i)).InBounds(msg) {
+						m.cursor[q] = i
+					}
+				}
+			}
+		}
+	}
+	return m, nil
+}
+
+// moveUp selects the previous task, climbing into the quadrant above from the top
+func (m *MatrixViewModel) moveUp() {
+	if m.cursor[m.quadrant] > 0 {
+		m.cursor[m.quadrant]--
+		return
+	}
+	if m.quadrant >= 2 {
+		m.quadrant -= 2
+		m.cursor[m.quadrant] = max(len(m.quadrants[m.quadrant].tasks)-1, 0)
+	}
+}
+
+// moveDown selects the next task, dropping into the quadrant below from the bottom
+func (m *MatrixViewModel) moveDown() {
+	if m.cursor[m.quadrant] < len(m.quadrants[m.quadrant].tasks)-1 {
+		m.cursor[m.quadrant]++
+		return
+	}
+	if m.quadrant < 2 {
+		m.quadrant += 2
+		m.cursor[m.quadrant] = 0
+	}
+}
+
+// UpdateTasks places the open tasks, soonest due first in each quadrant
+func (m *MatrixViewModel) UpdateTasks(tasks []models.Task) {
+	var selectedID string
+	if task, ok := m.SelectedTask(); ok {
+		selectedID = task.ID
+	}
+
+	for q := range m.quadrants {
+		m.quadrants[q].tasks = nil
+	}
+	now := time.Now()
+	for _, task := range tasks {
+		if task.Completed {
+			continue
+		}
+		q := m.quadrantOf(task, now)
+		m.quadrants[q].tasks = append(m.quadrants[q].tasks, task)
+	}
+
+	for q := range m.quadrants {
+		qt := m.quadrants[q].tasks
+		slices.SortStableFunc(qt, func(a, b models.Task) int {
+			return a.DueDate.Compare(b.DueDate)
+		})
+		for i, task := range qt {
+			if task.ID == selectedID {
+				m.quadrant = q
+				m.cursor[q] = i
+			}
+		}
+		m.cursor[q] = max(min(m.cursor[q], len(qt)-1), 0)
+	}
+}
+
+// quadrantOf places a task by its priority and how soon it is due
+func (m MatrixViewModel) quadrantOf(task models.Task, now time.Time) int {
+	q := quadrantDo
+	if task.Priority < m.important {
+		q += 2
+	}
+	if !m.urgent(task, now) {
+		q++
+	}
+	return q
+}
+
+// urgent reports whether the task is overdue or due within the urgent
+// window. Tasks without a due date are never urgent.
+func (m MatrixViewModel) urgent(task models.Task, now time.Time) bool {
+	return task.DueBefore(now.Add(m.urgentWithin))
+}
+
+func (m MatrixViewModel) SelectedTask() (models.Task, bool) {
+	tasks := m.quadrants[m.quadrant].tasks
+	if len(tasks) == 0 {
+		return models.Task{}, false
+	}
+	return tasks[m.cursor[m.quadrant]], true
+}
+
+func (m MatrixViewModel) ShouldReturn() bool {
+	return m.shouldReturn
+}
+
+// ResetReturn clears the return request so the matrix can be shown again
+func (m *MatrixViewModel) ResetReturn() {
+	m.shouldReturn = false
+}
+
+// Clickable regions of the matrix
+func quadrantZone(q int) string {
+	return fmt.Sprintf("matrix.quadrant.%d", q)
+}
+
+func matrixItemZone(q, i int) string {
+	return fmt.Sprintf("matrix.item.%d.%d", q, i)
+}
+
+// quadrantSize is the outer width and height of each quadrant
+func (m MatrixViewModel) quadrantSize() (int, int) {
+	// Leave room for the frame, the title, the axis labels and the status line
+	return max((m.width-4)/2, 20), max((m.height-5)/2, 6)
+}
+
+// window returns the range of a quadrant's tasks that fit around its cursor
+func (m MatrixViewModel) window(q int) (int, int) {
+	_, height := m.quadrantSize()
+	// Border, title and hint lines
+	visible := max(height-5, 1)
+	start := max(m.cursor[q]-visible+1, 0)
+	return start, min(start+visible, len(m.quadrants[q].tasks))
+}
+
+func (m MatrixViewModel) View() string {
+	width, height := m.quadrantSize()
+
+	rendered := make([]string, quadrantCount)
+	now := time.Now()
+	for q, quadrant := range m.quadrants {
+		var b strings.Builder
+		b.WriteString(quadrantTitleStyle.Render(fmt.Sprintf("%s (%d)", quadrant.title, len(quadrant.tasks))))
+		b.WriteByte('\n')
+		b.WriteString(quadrantHintStyle.Render(quadrant.hint))
+		b.WriteByte('\n')
+
+		if len(quadrant.tasks) == 0 {
+			b.WriteString(matrixEmptyStyle.Render("Nothing here"))
+		}
+		start, end := m.window(q)
+		lines := make([]string, 0, end-start)
+		for i := start; i < end; i++ {
+			selected := q == m.quadrant && i == m.cursor[q]
+			lines = append(lines, zone.Mark(matrixItemZone(q, i), m.renderItem(quadrant.tasks[i], width-4, selected, now)))
+		}
+		b.WriteString(strings.Join(lines, "\n"))
+
+		style := quadrantStyle
+		if q == m.quadrant {
+			style = activeQuadrantStyle
+		}
+		rendered[q] = zone.Mark(quadrantZone(q), style.
+			Width(width-2).
+			Height(height-2).
+			Render(b.String()))
+	}
+
+	axis := lipgloss.JoinHorizontal(lipgloss.Top,
+		matrixAxisStyle.Width(width).Render("Urgent"),
+		matrixAxisStyle.Width(width).Render("Not urgent"),
+	)
+
+	var content strings.Builder
+	content.WriteString(titleStyle.Render(withIcon(glyphs.Matrix, "Eisenhower matrix")))
+	content.WriteByte('\n')
+	content.WriteString(axis)
+	content.WriteByte('\n')
+	content.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, rendered[quadrantDo], rendered[quadrantSchedule]))
+	content.WriteByte('\n')
+	content.WriteString(lipgloss.JoinHorizontal(lipgloss.Top, rendered[quadrantDelegate], rendered[quadrantEliminate]))
+	content.WriteByte('\n')
+
+	status := fmt.Sprintf("Important: %s and above %s Urgent: due within %s %s %s",
+		m.important, glyphs.ActionSeparator, shortDuration(m.urgentWithin),
+		glyphs.ActionSeparator, shortHint(keys.Matrix.ShortHelp()))
+	content.WriteString(statusStyle.Render(status))
+
+	return baseStyle.
+		Width(m.width - 2).
+		Height(m.height - 2).
+		Render(content.String())
+}
+
+func (m MatrixViewModel) renderItem(task models.Task, width int, selected bool, now time.Time) string {
+	due := relativeDue(models.DueDeadline(task.DueDate), now)
+	prefix := ""
+	if selected && glyphs.Markers {
+		prefix = cursorMarker + " "
+	}
+	title := ansi.Truncate(task.Title, max(width-len(prefix)-lipgloss.Width(due)-2, 1), glyphs.Ellipsis)
+
+	if selected {
+		return matrixSelectedStyle.Width(width).Render(prefix + title + "  " + due)
+	}
+
+	title = lipgloss.NewStyle().Foreground(theme.PriorityColor(task.Priority)).Render(title)
+	dueStyle := matrixRelaxedDueStyle
+	if task.DueBefore(now) {
+		dueStyle = matrixUrgentDueStyle
+	}
+	return title + "  " + dueStyle.Render(due)
+}
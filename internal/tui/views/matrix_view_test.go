@@ -0,0 +1,33 @@
+package views
+
+import (
+	"testing"
+	"time"
+
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+func TestMatrixUndatedTasksAreNotUrgent(t *testing.T) {
+	m := NewMatrixViewModel(models.HighestPriority(), 48*time.Hour)
+	now := time.Now()
+	y, mo, d := now.Date()
+	today := time.Date(y, mo, d, 0, 0, 0, 0, time.UTC)
+
+	tests := []struct {
+		title    string
+		due      time.Time
+		priority models.PriorityLevel
+		want     int
+	}{
+		{"undated important", time.Time{}, models.HighestPriority(), quadrantSchedule},
+		{"undated", time.Time{}, models.Low, quadrantEliminate},
+		{"due today", today, models.HighestPriority(), quadrantDo},
+		{"due today, not important", today, models.Low, quadrantDelegate},
+	}
+	for _, tt := range tests {
+		task := models.NewTask(tt.title, "", tt.due, tt.priority)
+		if got := m.quadrantOf(task, now); got != tt.want {
+			t.Errorf("%s: quadrant %d, want %d", tt.title, got, tt.want)
+		}
+	}
+}
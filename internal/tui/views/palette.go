@@ -0,0 +1,179 @@
+package views
+
+import (
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sahilm/fuzzy"
+)
+
+var (
+	paletteStyle         lipgloss.Style
+	paletteItemStyle     lipgloss.Style
+	paletteSelectedStyle lipgloss.Style
+	paletteKeyStyle      lipgloss.Style
+)
+
+// setPaletteStyles derives the command palette's styles from t
+func setPaletteStyles(t Theme) {
+	paletteStyle = lipgloss.NewStyle().
+		Border(glyphs.Border).
+		BorderForeground(t.Accent).
+		Padding(0, 1)
+
+	paletteItemStyle = lipgloss.NewStyle().
+		PaddingLeft(1)
+
+	paletteSelectedStyle = paletteItemStyle.
+		Background(t.Accent).
+		Foreground(t.OnAccent)
+
+	paletteKeyStyle = lipgloss.NewStyle().
+		Foreground(t.Muted)
+}
+
+const (
+	paletteWidth   = 50
+	paletteVisible = 10
+)
+
+// commandSource lets fuzzy match against command names and sections
+type commandSource []Command
+
+func (s commandSource) String(i int) string { return s[i].Name + " " + s[i].Section }
+func (s commandSource) Len() int            { return len(s) }
+
+type PaletteModel struct {
+	input    textinput.Model
+	commands []Command
+	matches  []Command
+	cursor   int
+	width    int
+	height   int
+	closed   bool
+}
+
+// NewPaletteModel opens the palette over the commands available in context
+func NewPaletteModel(context string, width, height int) PaletteModel {
+	input := textinput.New()
+	input.Placeholder = "Type a command…"
+	input.Prompt = "> "
+	input.Width = paletteWidth - 6
+	input.Cursor.Style = cursorStyle
+	input.Focus()
+
+	commands := CommandsFor(context)
+	return PaletteModel{
+		input:    input,
+		commands: commands,
+		matches:  commands,
+		width:    width,
+		height:   height,
+	}
+}
+
+func (m PaletteModel) Init() tea.Cmd {
+	return textinput.Blink
+}
+
+func (m PaletteModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+		return m, nil
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Palette.Close, keys.Global.Palette):
+			m.closed = true
+			return m, nil
+		case key.Matches(msg, keys.Palette.Up):
+			if m.cursor > 0 {
+				m.cursor--
+			}
+			return m, nil
+		case key.Matches(msg, keys.Palette.Down):
+			if m.cursor < len(m.matches)-1 {
+				m.cursor++
+			}
+			return m, nil
+		case key.Matches(msg, keys.Palette.Run):
+			m.closed = true
+			if m.cursor < len(m.matches) {
+				command := m.matches[m.cursor]
+				return m, func() tea.Msg {
+					return RunCommandMsg{Command: command}
+				}
+			}
+			return m, nil
+		}
+	}
+
+	var cmd tea.Cmd
+	m.input, cmd = m.input.Update(msg)
+	m.filter()
+	return m, cmd
+}
+
+// filter narrows the command list to fuzzy matches of the query, best first
+func (m *PaletteModel) filter() {
+	query := strings.TrimSpace(m.input.Value())
+	if query == "" {
+		m.matches = m.commands
+	} else {
+		found := fuzzy.FindFrom(query, commandSource(m.commands))
+		m.matches = make([]Command, len(found))
+		for i, match := range found {
+			m.matches[i] = m.commands[match.Index]
+		}
+	}
+	if m.cursor >= len(m.matches) {
+		m.cursor = max(len(m.matches)-1, 0)
+	}
+}
+
+// Closed reports whether the palette was dismissed or a command was picked
+func (m PaletteModel) Closed() bool {
+	return m.closed
+}
+
+func (m PaletteModel) View() string {
+	var content strings.Builder
+	content.WriteString(m.input.View())
+	content.WriteString("\n")
+
+	if len(m.matches) == 0 {
+		content.WriteString(helpDescStyle.Render("No matching commands"))
+	}
+
+	// Keep the cursor inside the visible window
+	start := max(m.cursor-paletteVisible+1, 0)
+	end := min(start+paletteVisible, len(m.matches))
+	for i := start; i < end; i++ {
+		command := m.matches[i]
+		keyHint := command.Binding.Help().Key
+		name := command.Name
+		gap := max(paletteWidth-6-lipgloss.Width(name)-lipgloss.Width(keyHint), 1)
+
+		if i == m.cursor {
+			content.WriteString(paletteSelectedStyle.Render(name + strings.Repeat(" ", gap) + keyHint))
+		} else {
+			content.WriteString(paletteItemStyle.Render(name + strings.Repeat(" ", gap) + paletteKeyStyle.Render(keyHint)))
+		}
+		if i < end-1 {
+			content.WriteString("\n")
+		}
+	}
+
+	return lipgloss.Place(
+		m.width,
+		m.height,
+		lipgloss.Center,
+		lipgloss.Center,
+		paletteStyle.Width(paletteWidth).Render(content.String()),
+	)
+}
@@ -0,0 +1,131 @@
+package views
+
+import (
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sabry-awad97/task-manager/internal/tui/zone"
+)
+
+var (
+	previewStyle       lipgloss.Style
+	previewEmptyStyle  lipgloss.Style
+	dividerStyle       lipgloss.Style
+	activeDividerStyle lipgloss.Style
+)
+
+// setPreviewStyles derives the preview pane's styles from t
+func setPreviewStyles(t Theme) {
+	previewStyle = lipgloss.NewStyle().
+		PaddingLeft(1)
+
+	previewEmptyStyle = lipgloss.NewStyle().
+		Foreground(t.Muted).
+		Italic(true)
+
+	dividerStyle = lipgloss.NewStyle().
+		Foreground(t.Border)
+
+	activeDividerStyle = lipgloss.NewStyle().
+		Foreground(t.Accent)
+}
+
+// Preview pane layout
+const (
+	previewMinTerminal = 120 // Narrower terminals never show the preview
+	previewMinWidth    = 30  // Narrowest the divider can make the pane
+	previewMinTable    = 60  // Narrowest the divider can make the table
+	previewStep        = 4   // Columns one resize key press moves the divider
+
+	dividerZone = "main.divider"
+)
+
+// showPreview reports whether the preview pane is open and fits the terminal
+func (m MainViewModel) showPreview() bool {
+	return m.preview && !display.ScreenReader && m.width >= previewMinTerminal
+}
+
+// previewPaneWidth is the width of the pane right of the divider. It starts
+// at two fifths of the content and stays within the pane and table minimums.
+func (m MainViewModel) previewPaneWidth() int {
+	width := m.previewWidth
+	if width == 0 {
+		width = m.contentWidth() * 2 / 5
+	}
+	return m.clampPreview(width)
+}
+
+func (m MainViewModel) clampPreview(width int) int {
+	return max(min(width, m.contentWidth()-previewMinTable-1), previewMinWidth)
+}
+
+// tableWidth is the content width left for the table beside the preview pane
+func (m MainViewModel) tableWidth() int {
+	if !m.showPreview() {
+		return m.contentWidth()
+	}
+	return m.contentWidth() - m.previewPaneWidth() - 1
+}
+
+// resizePreview sets the pane width and refits the table columns around it
+func (m *MainViewModel) resizePreview(width int) {
+	m.previewWidth = m.clampPreview(width)
+	m.layoutColumns()
+}
+
+// updateDivider drags the divider between the table and the preview pane.
+// It reports whether the mouse event was used.
+func (m *MainViewModel) updateDivider(msg tea.MouseMsg) bool {
+	switch msg.Action {
+	case tea.MouseActionPress:
+		if msg.Button == tea.MouseButtonLeft && m.showPreview() && zone.Get(dividerZone).InBounds(msg) {
+			m.resizing = true
+			return true
+		}
+	case tea.MouseActionMotion:
+		if m.resizing {
+			// The pane grows by however far the mouse moved left of the divider
+			if divider := zone.Get(dividerZone); divider.Found() {
+				m.resizePreview(m.previewPaneWidth() + divider.StartX - msg.X)
+			}
+			return true
+		}
+	case tea.MouseActionRelease:
+		if m.resizing {
+			m.resizing = false
+			return true
+		}
+	}
+	return false
+}
+
+// renderDivider draws the draggable rule between the table and the preview
+func (m MainViewModel) renderDivider(height int) string {
+	style := dividerStyle
+	if m.resizing {
+		style = activeDividerStyle
+	}
+	rule := strings.TrimSuffix(strings.Repeat(glyphs.Border.Left+"\n", height), "\n")
+	return zone.Mark(dividerZone, style.Render(rule))
+}
+
+// renderPreview draws the selected task's details in the right-hand pane
+func (m MainViewModel) renderPreview(height int) string {
+	width := m.previewPaneWidth()
+	var content string
+	if task, ok := m.SelectedTask(); ok {
+		inner := width - previewStyle.GetHorizontalPadding()
+		content = detailHeaderStyle.Render(withIcon(glyphs.Detail, "Preview")) + "\n" +
+			renderDetails(taskDetails(task), inner) +
+			renderChecklist(task, -1, inner)
+	} else {
+		content = previewEmptyStyle.Render("No task selected")
+	}
+
+	return previewStyle.
+		Width(width).
+		Height(height).
+		MaxHeight(height).
+		Render(content)
+}
@@ -0,0 +1,241 @@
+package views
+
+import (
+	"fmt"
+	"slices"
+
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sabry-awad97/task-manager/internal/config"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+// Theme is the colour palette every style in the views is derived from
+type Theme struct {
+	Name      string
+	Accent    lipgloss.Color // Titles, focused borders and the selection background
+	Secondary lipgloss.Color // Headings and labels
+	Border    lipgloss.Color // Idle borders and separators
+	Muted     lipgloss.Color // Hints, dates and other secondary text
+	Text      lipgloss.Color // Body text
+	OnAccent  lipgloss.Color // Text drawn on the accent colour
+	Marked    lipgloss.Color // Background of marked rows
+	Success   lipgloss.Color
+	Warning   lipgloss.Color
+	Danger    lipgloss.Color
+
+	// Priority colours indexed by priority level. Missing entries fall back
+	// to the priority's own colour.
+	Priority []lipgloss.Color
+}
+
+// Names of the built-in themes. ThemeAuto picks dark or light from the
+// terminal background.
+const (
+	ThemeAuto         = "auto"
+	ThemeDark         = "dark"
+	ThemeLight        = "light"
+	ThemeHighContrast = "high-contrast"
+	ThemeSolarized    = "solarized"
+)
+
+var builtinThemes = []Theme{
+	{
+		Name:      ThemeDark,
+		Accent:    "205",
+		Secondary: "99",
+		Border:    "240",
+		Muted:     "241",
+		Text:      "252",
+		OnAccent:  "0",
+		Marked:    "236",
+		Success:   "42",
+		Warning:   "214",
+		Danger:    "196",
+	},
+	{
+		Name:      ThemeLight,
+		Accent:    "161",
+		Secondary: "55",
+		Border:    "250",
+		Muted:     "244",
+		Text:      "235",
+		OnAccent:  "255",
+		Marked:    "254",
+		Success:   "28",
+		Warning:   "166",
+		Danger:    "160",
+		Priority:  []lipgloss.Color{"28", "166", "160"},
+	},
+	{
+		Name:      ThemeHighContrast,
+		Accent:    "14",
+		Secondary: "13",
+		Border:    "15",
+		Muted:     "7",
+		Text:      "15",
+		OnAccent:  "0",
+		Marked:    "238",
+		Success:   "10",
+		Warning:   "11",
+		Danger:    "9",
+		Priority:  []lipgloss.Color{"10", "11", "9"},
+	},
+	{
+		Name:      ThemeSolarized,
+		Accent:    "#d33682",
+		Secondary: "#6c71c4",
+		Border:    "#586e75",
+		Muted:     "#657b83",
+		Text:      "#93a1a1",
+		OnAccent:  "#fdf6e3",
+		Marked:    "#073642",
+		Success:   "#859900",
+		Warning:   "#b58900",
+		Danger:    "#dc322f",
+		Priority:  []lipgloss.Color{"#859900", "#cb4b16", "#dc322f"},
+	},
+}
+
+// theme is the live theme the package styles were last derived from
+var theme Theme
+
+// themes is the cycle order for runtime switching: built-ins, then custom themes
+var themes = slices.Clone(builtinThemes)
+
+func init() {
+	SetTheme(builtinThemes[0])
+}
+
+// PriorityColor returns the colour for a priority level. A colour set on
+// the scale wins; otherwise the theme's low, medium or high colour is
+// picked by the level's position on the scale.
+func (t Theme) PriorityColor(p models.PriorityLevel) lipgloss.Color {
+	if p.Valid() && models.PriorityScale()[p].Color != "" {
+		return lipgloss.Color(p.Color())
+	}
+	if len(t.Priority) > 0 {
+		if c := t.Priority[p.Band(len(t.Priority))]; c != "" {
+			return c
+		}
+	}
+	return lipgloss.Color(p.Color())
+}
+
+// StatusColor returns the colour for a workflow state
+func (t Theme) StatusColor(s models.Status) lipgloss.Color {
+	if s.Color == "" {
+		return t.Text
+	}
+	return lipgloss.Color(s.Color)
+}
+
+// CurrentTheme returns the live theme
+func CurrentTheme() Theme {
+	return theme
+}
+
+// SetTheme makes t the live theme and rebuilds every style from it
+func SetTheme(t Theme) {
+	theme = t
+	setMainStyles(t)
+	setBulkStyles(t)
+	setPreviewStyles(t)
+	setBoardStyles(t)
+	setCalendarStyles(t)
+	setAgendaStyles(t)
+	setFormStyles(t)
+	setDetailStyles(t)
+	setErrorStyles(t)
+	setPaletteStyles(t)
+	setConfirmStyles(t)
+	setTrashStyles(t)
+	setArchiveStyles(t)
+	setMatrixStyles(t)
+	setEffortStyles(t)
+	setFocusStyles(t)
+	setHelpStyles(t)
+}
+
+// LoadThemes registers the custom themes from cfg and returns the theme named
+// by name. "auto" picks dark or light from the terminal background.
+func LoadThemes(name string, custom map[string]config.Theme) (Theme, error) {
+	themes = slices.Clone(builtinThemes)
+
+	// Sort custom themes so switching cycles through them in a stable order
+	names := make([]string, 0, len(custom))
+	for n := range custom {
+		names = append(names, n)
+	}
+	slices.Sort(names)
+
+	for _, n := range names {
+		c := custom[n]
+		base := ThemeDark
+		if c.Base != "" {
+			base = c.Base
+		}
+		t, ok := findTheme(base)
+		if !ok {
+			return Theme{}, fmt.Errorf("theme %q: unknown base theme %q", n, base)
+		}
+		t.Name = n
+		t.Priority = slices.Clone(t.Priority)
+		overrideColor(&t.Accent, c.Accent)
+		overrideColor(&t.Secondary, c.Secondary)
+		overrideColor(&t.Border, c.Border)
+		overrideColor(&t.Muted, c.Muted)
+		overrideColor(&t.Text, c.Text)
+		overrideColor(&t.OnAccent, c.OnAccent)
+		overrideColor(&t.Marked, c.Marked)
+		overrideColor(&t.Success, c.Success)
+		overrideColor(&t.Warning, c.Warning)
+		overrideColor(&t.Danger, c.Danger)
+		for i, color := range c.Priority {
+			for len(t.Priority) <= i {
+				t.Priority = append(t.Priority, "")
+			}
+			overrideColor(&t.Priority[i], color)
+		}
+		themes = append(themes, t)
+	}
+
+	if name == "" || name == ThemeAuto {
+		name = ThemeLight
+		if lipgloss.HasDarkBackground() {
+			name = ThemeDark
+		}
+	}
+	t, ok := findTheme(name)
+	if !ok {
+		return Theme{}, fmt.Errorf("unknown theme %q", name)
+	}
+	return t, nil
+}
+
+// NextTheme returns the theme after the live one in the switching order
+func NextTheme() Theme {
+	i := slices.IndexFunc(themes, func(t Theme) bool { return t.Name == theme.Name })
+	return themes[(i+1)%len(themes)]
+}
+
+func findTheme(name string) (Theme, bool) {
+	i := slices.IndexFunc(themes, func(t Theme) bool { return t.Name == name })
+	if i < 0 {
+		return Theme{}, false
+	}
+	return themes[i], true
+}
+
+func overrideColor(dst *lipgloss.Color, value string) {
+	if value != "" {
+		*dst = lipgloss.Color(value)
+	}
+}
+
+// colorOr returns the configured colour, or fallback when none is set
+func colorOr(value string, fallback lipgloss.Color) lipgloss.Color {
+	if value == "" {
+		return fallback
+	}
+	return lipgloss.Color(value)
+}
@@ -0,0 +1,309 @@
+package views
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+	"github.com/sabry-awad97/task-manager/internal/tui/zone"
+)
+
+type timeLogKeyMap struct {
+	Up     key.Binding
+	Down   key.Binding
+	Add    key.Binding
+	Edit   key.Binding
+	Delete key.Binding
+	Timer  key.Binding
+	Back   key.Binding
+}
+
+func (k timeLogKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Timer, k.Add, k.Edit, k.Delete, k.Back}
+}
+
+func (k timeLogKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down},
+		{k.Timer, k.Add, k.Edit, k.Delete},
+		{k.Back},
+	}
+}
+
+// TimeLogViewModel lists and edits the time entries of one task
+type TimeLogViewModel struct {
+	task         models.Task
+	found        bool // The task is still in the list
+	cursor       int  // Selected entry, counted from the newest
+	prompt       textinput.Model
+	prompting    bool
+	editing      int // Entry being edited, or -1 when adding one
+	promptErr    string
+	width        int
+	height       int
+	shouldReturn bool
+}
+
+func NewTimeLogViewModel(task models.Task) TimeLogViewModel {
+	return TimeLogViewModel{task: task, found: true, editing: -1}
+}
+
+func (m TimeLogViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m TimeLogViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		if m.prompting {
+			return m.updatePrompt(msg)
+		}
+		switch {
+		case key.Matches(msg, keys.TimeLog.Back):
+			m.shouldReturn = true
+		case key.Matches(msg, keys.TimeLog.Up):
+			m.cursor = max(m.cursor-1, 0)
+		case key.Matches(msg, keys.TimeLog.Down):
+			m.cursor = max(min(m.cursor+1, len(m.task.TimeEntries)-1), 0)
+		case !m.found:
+		case key.Matches(msg, keys.TimeLog.Timer):
+			return m, func() tea.Msg { return ToggleTimerMsg{TaskID: m.task.ID} }
+		case key.Matches(msg, keys.TimeLog.Add):
+			m.openPrompt(-1)
+			return m, textinput.Blink
+		case key.Matches(msg, keys.TimeLog.Edit):
+			if i, ok := m.selected(); ok {
+				m.openPrompt(i)
+				return m, textinput.Blink
+			}
+		case key.Matches(msg, keys.TimeLog.Delete):
+			if i, ok := m.selected(); ok {
+				entry := m.task.TimeEntries[i]
+				return m, func() tea.Msg {
+					return ConfirmMsg{
+						Title: "Delete time entry",
+						Prompt: fmt.Sprintf("Delete %s logged on %q?",
+							formatDuration(entry.Duration(time.Now())), m.task.Title),
+						Confirm: SetTimeEntriesMsg{TaskID: m.task.ID, Entries: slices.Delete(slices.Clone(m.task.TimeEntries), i, i+1)},
+					}
+				}
+			}
+		}
+
+	case tea.MouseMsg:
+		if msg.Action != tea.MouseActionPress {
+			break
+		}
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.cursor = max(m.cursor-wheelStep, 0)
+		case tea.MouseButtonWheelDown:
+			m.cursor = max(min(m.cursor+wheelStep, len(m.task.TimeEntries)-1), 0)
+		case tea.MouseButtonLeft:
+			start, end := m.window()
+			for i := start; i < end; i++ {
+				if zone.Get(timeLogRowZone(i)).InBounds(msg) {
+					m.cursor = i
+				}
+			}
+		}
+
+	default:
+		// Cursor blink for the prompt
+		if m.prompting {
+			var cmd tea.Cmd
+			m.prompt, cmd = m.prompt.Update(msg)
+			return m, cmd
+		}
+	}
+	return m, nil
+}
+
+// openPrompt asks for a new entry, or for new times of entry i
+func (m *TimeLogViewModel) openPrompt(i int) {
+	m.prompting = true
+	m.editing = i
+	m.promptErr = ""
+
+	label := "Log time"
+	if i >= 0 {
+		label = "Edit entry"
+	}
+	m.prompt = textinput.New()
+	m.prompt.Prompt = promptStyle.Render(label+": ") + " "
+	m.prompt.Placeholder = timeEntryHint
+	m.prompt.CharLimit = 40
+	m.prompt.Width = 44
+	m.prompt.Cursor.Style = cursorStyle
+	if i >= 0 {
+		m.prompt.SetValue(formatTimeEntry(m.task.TimeEntries[i], time.Now()))
+	}
+	m.prompt.Focus()
+}
+
+// updatePrompt feeds a key to the entry prompt and saves the entry on submit
+func (m TimeLogViewModel) updatePrompt(msg tea.KeyMsg) (TimeLogViewModel, tea.Cmd) {
+	switch {
+	case key.Matches(msg, keys.Prompt.Cancel):
+		m.prompting = false
+		m.promptErr = ""
+		return m, nil
+	case key.Matches(msg, keys.Prompt.Submit):
+		var base models.TimeEntry
+		if m.editing >= 0 {
+			base = m.task.TimeEntries[m.editing]
+		}
+		entry, err := parseTimeEntry(strings.TrimSpace(m.prompt.Value()), base, time.Now())
+		if err != nil {
+			m.promptErr = err.Error()
+			return m, nil
+		}
+
+		entries := slices.Clone(m.task.TimeEntries)
+		if m.editing >= 0 {
+			entries[m.editing] = entry
+		} else {
+			entries = append(entries, entry)
+		}
+		// Keep the entries in the order they were worked
+		slices.SortStableFunc(entries, func(a, b models.TimeEntry) int {
+			return a.Start.Compare(b.Start)
+		})
+		m.prompting = false
+		m.promptErr = ""
+		id := m.task.ID
+		return m, func() tea.Msg { return SetTimeEntriesMsg{TaskID: id, Entries: entries} }
+	}
+
+	var cmd tea.Cmd
+	m.prompt, cmd = m.prompt.Update(msg)
+	return m, cmd
+}
+
+// Capturing reports whether the prompt is reading text and needs every key
+func (m TimeLogViewModel) Capturing() bool {
+	return m.prompting
+}
+
+// UpdateTasks refreshes the shown task from tasks, by ID
+func (m *TimeLogViewModel) UpdateTasks(tasks []models.Task) {
+	i := slices.IndexFunc(tasks, func(t models.Task) bool { return t.ID == m.task.ID })
+	m.found = i >= 0
+	if m.found {
+		m.task = tasks[i]
+	}
+	m.cursor = max(min(m.cursor, len(m.task.TimeEntries)-1), 0)
+}
+
+// selected returns the index in the task's entries of the entry under the cursor
+func (m TimeLogViewModel) selected() (int, bool) {
+	n := len(m.task.TimeEntries)
+	if m.cursor >= n {
+		return 0, false
+	}
+	return n - 1 - m.cursor, true
+}
+
+func (m TimeLogViewModel) ShouldReturn() bool {
+	return m.shouldReturn
+}
+
+// ResetReturn clears the return request so the log can be shown again
+func (m *TimeLogViewModel) ResetReturn() {
+	m.shouldReturn = false
+}
+
+func timeLogRowZone(i int) string {
+	return fmt.Sprintf("time_log.row.%d", i)
+}
+
+// window returns the range of entries that fit on screen around the cursor
+func (m TimeLogViewModel) window() (int, int) {
+	// Leave room for the frame, the title, the summary, the prompt and the status line
+	height := len(m.task.TimeEntries)
+	if m.height > 0 {
+		height = max(m.height-10, 1)
+	}
+	start := max(m.cursor-height+1, 0)
+	return start, min(start+height, len(m.task.TimeEntries))
+}
+
+func (m TimeLogViewModel) View() string {
+	now := time.Now()
+	var content strings.Builder
+
+	content.WriteString(titleStyle.Render(withIcon(glyphs.Timer, "Time log — "+m.task.Title)))
+	content.WriteByte('\n')
+
+	summary := fmt.Sprintf("Total %s %s %d entries", formatDuration(m.task.Tracked(now)),
+		glyphs.ActionSeparator, len(m.task.TimeEntries))
+	if start, ok := m.task.RunningSince(); ok {
+		summary += fmt.Sprintf(" %s running %s", glyphs.ActionSeparator, formatClock(now.Sub(start)))
+	}
+	content.WriteString(trashAgeStyle.Render(summary))
+	content.WriteString("\n\n")
+
+	if len(m.task.TimeEntries) == 0 {
+		content.WriteString(trashEmptyStyle.Render("No time logged yet"))
+		content.WriteByte('\n')
+	}
+
+	start, end := m.window()
+	for i := start; i < end; i++ {
+		entry := m.task.TimeEntries[len(m.task.TimeEntries)-1-i]
+		content.WriteString(zone.Mark(timeLogRowZone(i), m.renderEntry(entry, i == m.cursor, now)))
+		content.WriteByte('\n')
+	}
+
+	content.WriteByte('\n')
+	if m.prompting {
+		content.WriteString(m.prompt.View())
+		if m.promptErr != "" {
+			content.WriteString("  " + errorStyle.Render(m.promptErr))
+		}
+	} else {
+		content.WriteString(statusStyle.Render(shortHint(keys.TimeLog.ShortHelp())))
+	}
+
+	return baseStyle.
+		Width(m.width - 2).
+		Height(m.height - 2).
+		Render(content.String())
+}
+
+func (m TimeLogViewModel) renderEntry(entry models.TimeEntry, selected bool, now time.Time) string {
+	start := entry.Start.Local()
+	span := start.Format("Mon 2006-01-02  15:04") + "-"
+	if entry.End != nil {
+		end := entry.End.Local()
+		span += end.Format("15:04")
+		// Mark entries that run past midnight
+		if days := int(truncateDay(end).Sub(truncateDay(start)).Hours() / 24); days > 0 {
+			span += fmt.Sprintf(" +%dd", days)
+		}
+	} else {
+		span += "now  "
+	}
+	length := formatDuration(entry.Duration(now))
+	if entry.Running() {
+		length += "  running"
+	}
+
+	if selected {
+		line := span + "  " + length
+		if glyphs.Markers {
+			line = cursorMarker + " " + line
+		}
+		return trashSelectedItemStyle.Render(line)
+	}
+	return trashItemStyle.Render(span + "  " + trashAgeStyle.Render(length))
+}
@@ -0,0 +1,150 @@
+package views
+
+import (
+	"fmt"
+	"regexp"
+	"time"
+
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+// ToggleTimerMsg starts the task's timer, stopping any other, or stops it
+// when it is the one running
+type ToggleTimerMsg struct {
+	TaskID string
+}
+
+// SetTimeEntriesMsg replaces the time entries logged on a task
+type SetTimeEntriesMsg struct {
+	TaskID  string
+	Entries []models.TimeEntry
+}
+
+// ShowTimeLogMsg opens the time entries of a task
+type ShowTimeLogMsg struct {
+	Task models.Task
+}
+
+// runningTimer finds the task whose timer is running
+func runningTimer(tasks []models.Task) (models.Task, time.Time, bool) {
+	for _, task := range tasks {
+		if start, ok := task.RunningSince(); ok {
+			return task, start, true
+		}
+	}
+	return models.Task{}, time.Time{}, false
+}
+
+// formatDuration shows tracked time in hours and minutes, e.g. "2h 05m" or "40m"
+func formatDuration(d time.Duration) string {
+	minutes := int(d.Round(time.Minute) / time.Minute)
+	if minutes < 60 {
+		return fmt.Sprintf("%dm", minutes)
+	}
+	return fmt.Sprintf("%dh %02dm", minutes/60, minutes%60)
+}
+
+// formatClock shows a running timer to the second, e.g. "1:02:09"
+func formatClock(d time.Duration) string {
+	seconds := int(d / time.Second)
+	return fmt.Sprintf("%d:%02d:%02d", seconds/3600, seconds/60%60, seconds%60)
+}
+
+// timeRangePattern matches "09:00-10:30", optionally after a "2006-01-02" date
+var timeRangePattern = regexp.MustCompile(`^(?:(\d{4}-\d{2}-\d{2})\s+)?(\d{1,2}:\d{2})\s*-\s*(\d{1,2}:\d{2})$`)
+
+// timeEntryHint describes what the time entry prompt accepts
+const timeEntryHint = "1h30m, 09:00-10:30 or 2006-01-02 09:00-10:30"
+
+// parseTimeRange reads "09:00-10:30" on day, or "2006-01-02 09:00-10:30".
+// A range ending before it starts runs past midnight.
+func parseTimeRange(s string, day time.Time) (models.TimeEntry, bool) {
+	parts := timeRangePattern.FindStringSubmatch(s)
+	if parts == nil {
+		return models.TimeEntry{}, false
+	}
+	day = day.Local()
+	if parts[1] != "" {
+		d, err := time.ParseInLocation("2006-01-02", parts[1], time.Local)
+		if err != nil {
+			return models.TimeEntry{}, false
+		}
+		day = d
+	}
+	clock := func(s string) (time.Time, bool) {
+		t, err := time.Parse("15:04", s)
+		if err != nil {
+			return time.Time{}, false
+		}
+		return time.Date(day.Year(), day.Month(), day.Day(), t.Hour(), t.Minute(), 0, 0, time.Local), true
+	}
+	start, ok := clock(parts[2])
+	if !ok {
+		return models.TimeEntry{}, false
+	}
+	end, ok := clock(parts[3])
+	if !ok {
+		return models.TimeEntry{}, false
+	}
+	if !end.After(start) {
+		end = end.AddDate(0, 0, 1)
+	}
+	return models.TimeEntry{Start: start, End: &end}, true
+}
+
+// parseTimeEntry reads a time entry typed into the prompt. A bare duration
+// keeps base's start, or ends now when base is empty; a time range replaces it.
+func parseTimeEntry(s string, base models.TimeEntry, now time.Time) (models.TimeEntry, error) {
+	day := now
+	if !base.Start.IsZero() {
+		day = base.Start
+	}
+	if entry, ok := parseTimeRange(s, day); ok {
+		return entry, nil
+	}
+
+	d, err := time.ParseDuration(s)
+	if err != nil || d <= 0 {
+		return models.TimeEntry{}, fmt.Errorf("use %s", timeEntryHint)
+	}
+	if base.Start.IsZero() {
+		return models.TimeEntry{Start: now.Add(-d), End: &now}, nil
+	}
+	end := base.Start.Add(d)
+	return models.TimeEntry{Start: base.Start, End: &end}, nil
+}
+
+// formatTimeEntry writes an entry the way the prompt reads it back
+func formatTimeEntry(e models.TimeEntry, now time.Time) string {
+	end := now
+	if e.End != nil {
+		end = *e.End
+	}
+	return e.Start.Local().Format("2006-01-02 15:04") + "-" + end.Local().Format("15:04")
+}
+
+// formatEstimate shows an estimate, or nothing when the task has none
+func formatEstimate(d time.Duration) string {
+	if d <= 0 {
+		return ""
+	}
+	return models.FormatEstimate(d)
+}
+
+// formatEffort compares the time tracked on a task with its estimate, e.g.
+// "1h 30m/2h"
+func formatEffort(task models.Task, now time.Time) string {
+	tracked := task.Tracked(now)
+	if task.Estimate <= 0 {
+		if tracked == 0 {
+			return ""
+		}
+		return models.FormatEstimate(tracked)
+	}
+	return models.FormatEstimate(tracked) + "/" + models.FormatEstimate(task.Estimate)
+}
+
+// overEstimate reports whether more time was tracked on the task than estimated
+func overEstimate(task models.Task, now time.Time) bool {
+	return task.Estimate > 0 && task.Tracked(now) > task.Estimate
+}
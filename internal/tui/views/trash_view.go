@@ -0,0 +1,259 @@
+package views
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+	"github.com/sabry-awad97/task-manager/internal/tui/zone"
+)
+
+var (
+	trashItemStyle         lipgloss.Style
+	trashSelectedItemStyle lipgloss.Style
+	trashAgeStyle          lipgloss.Style
+	trashEmptyStyle        lipgloss.Style
+)
+
+// setTrashStyles derives the trash view's styles from t
+func setTrashStyles(t Theme) {
+	trashItemStyle = lipgloss.NewStyle().
+		PaddingLeft(2)
+
+	trashSelectedItemStyle = trashItemStyle.
+		Background(t.Accent).
+		Foreground(t.OnAccent)
+
+	trashAgeStyle = lipgloss.NewStyle().
+		Foreground(t.Muted)
+
+	trashEmptyStyle = lipgloss.NewStyle().
+		Foreground(t.Muted).
+		Italic(true).
+		PaddingLeft(2)
+}
+
+type trashKeyMap struct {
+	Up      key.Binding
+	Down    key.Binding
+	Restore key.Binding
+	Purge   key.Binding
+	Empty   key.Binding
+	Back    key.Binding
+}
+
+func (k trashKeyMap) ShortHelp() []key.Binding {
+	return []key.Binding{k.Restore, k.Purge, k.Empty, k.Back}
+}
+
+func (k trashKeyMap) FullHelp() [][]key.Binding {
+	return [][]key.Binding{
+		{k.Up, k.Down},
+		{k.Restore, k.Purge, k.Empty},
+		{k.Back},
+	}
+}
+
+// TrashTasksMsg moves tasks to the trash
+type TrashTasksMsg struct {
+	TaskIDs []string
+}
+
+// RestoreTasksMsg takes tasks back out of the trash
+type RestoreTasksMsg struct {
+	TaskIDs []string
+}
+
+// PurgeTasksMsg deletes trashed tasks for good
+type PurgeTasksMsg struct {
+	TaskIDs []string
+}
+
+type TrashViewModel struct {
+	tasks        []models.Task // Most recently deleted first
+	cursor       int
+	days         int // Age at which trashed tasks are purged, 0 for never
+	width        int
+	height       int
+	shouldReturn bool
+}
+
+// NewTrashViewModel lists trashed tasks. days is the auto-purge age shown
+// next to each task, or 0 when auto-purge is off.
+func NewTrashViewModel(days int) TrashViewModel {
+	return TrashViewModel{days: days}
+}
+
+func (m TrashViewModel) Init() tea.Cmd {
+	return nil
+}
+
+func (m TrashViewModel) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
+	switch msg := msg.(type) {
+	case tea.WindowSizeMsg:
+		m.width = msg.Width
+		m.height = msg.Height
+
+	case tea.KeyMsg:
+		switch {
+		case key.Matches(msg, keys.Trash.Back):
+			m.shouldReturn = true
+		case key.Matches(msg, keys.Trash.Up):
+			m.cursor = max(m.cursor-1, 0)
+		case key.Matches(msg, keys.Trash.Down):
+			m.cursor = max(min(m.cursor+1, len(m.tasks)-1), 0)
+		case key.Matches(msg, keys.Trash.Restore):
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return RestoreTasksMsg{TaskIDs: []string{task.ID}}
+				}
+			}
+		case key.Matches(msg, keys.Trash.Purge):
+			if task, ok := m.SelectedTask(); ok {
+				return m, func() tea.Msg {
+					return ConfirmMsg{
+						Title:   "Delete forever",
+						Prompt:  fmt.Sprintf("Delete %q for good? It cannot be restored.", task.Title),
+						Confirm: PurgeTasksMsg{TaskIDs: []string{task.ID}},
+					}
+				}
+			}
+		case key.Matches(msg, keys.Trash.Empty):
+			if len(m.tasks) > 0 {
+				ids := make([]string, len(m.tasks))
+				for i, task := range m.tasks {
+					ids[i] = task.ID
+				}
+				return m, func() tea.Msg {
+					return ConfirmMsg{
+						Title:   "Empty trash",
+						Prompt:  fmt.Sprintf("Delete all %d tasks in the trash for good? They cannot be restored.", len(ids)),
+						Confirm: PurgeTasksMsg{TaskIDs: ids},
+					}
+				}
+			}
+		}
+
+	case tea.MouseMsg:
+		if msg.Action != tea.MouseActionPress {
+			break
+		}
+		switch msg.Button {
+		case tea.MouseButtonWheelUp:
+			m.cursor = max(m.cursor-wheelStep, 0)
+		case tea.MouseButtonWheelDown:
+			m.cursor = max(min(m.cursor+wheelStep, len(m.tasks)-1), 0)
+		case tea.MouseButtonLeft:
+			start, end := m.window()
+			for i := start; i < end; i++ {
+				if zone.Get(trashRowZone(i)).InBounds(msg) {
+					m.cursor = i
+				}
+			}
+		}
+	}
+	return m, nil
+}
+
+// UpdateTasks lists the trashed tasks, most recently deleted first
+func (m *TrashViewModel) UpdateTasks(tasks []models.Task) {
+	m.tasks = slices.Clone(tasks)
+	slices.SortStableFunc(m.tasks, func(a, b models.Task) int {
+		return b.DeletedAt.Compare(*a.DeletedAt)
+	})
+	m.cursor = max(min(m.cursor, len(m.tasks)-1), 0)
+}
+
+func (m TrashViewModel) SelectedTask() (models.Task, bool) {
+	if m.cursor < 0 || m.cursor >= len(m.tasks) {
+		return models.Task{}, false
+	}
+	return m.tasks[m.cursor], true
+}
+
+func (m TrashViewModel) ShouldReturn() bool {
+	return m.shouldReturn
+}
+
+// ResetReturn clears the return request so the trash can be shown again
+func (m *TrashViewModel) ResetReturn() {
+	m.shouldReturn = false
+}
+
+func trashRowZone(i int) string {
+	return fmt.Sprintf("trash.row.%d", i)
+}
+
+// window returns the range of tasks that fit on screen around the cursor
+func (m TrashViewModel) window() (int, int) {
+	// Leave room for the frame, the title, the summary and the status line
+	height := len(m.tasks)
+	if m.height > 0 {
+		height = max(m.height-8, 1)
+	}
+	start := max(m.cursor-height+1, 0)
+	return start, min(start+height, len(m.tasks))
+}
+
+func (m TrashViewModel) View() string {
+	var content strings.Builder
+
+	content.WriteString(titleStyle.Render(withIcon(glyphs.Trash, "Trash")))
+	content.WriteByte('\n')
+
+	summary := fmt.Sprintf("%d deleted tasks", len(m.tasks))
+	if m.days > 0 {
+		summary += fmt.Sprintf(" %s purged after %d days", glyphs.ActionSeparator, m.days)
+	}
+	content.WriteString(trashAgeStyle.Render(summary))
+	content.WriteString("\n\n")
+
+	if len(m.tasks) == 0 {
+		content.WriteString(trashEmptyStyle.Render("Trash is empty"))
+		content.WriteByte('\n')
+	}
+
+	now := time.Now()
+	start, end := m.window()
+	for i := start; i < end; i++ {
+		content.WriteString(zone.Mark(trashRowZone(i), m.renderItem(m.tasks[i], i == m.cursor, now)))
+		content.WriteByte('\n')
+	}
+
+	content.WriteByte('\n')
+	content.WriteString(statusStyle.Render(shortHint(keys.Trash.ShortHelp())))
+
+	return baseStyle.
+		Width(m.width - 2).
+		Height(m.height - 2).
+		Render(content.String())
+}
+
+func (m TrashViewModel) renderItem(task models.Task, selected bool, now time.Time) string {
+	age := now.Sub(*task.DeletedAt)
+	when := "deleted just now"
+	if age >= time.Minute {
+		when = "deleted " + shortDuration(age) + " ago"
+	}
+	if m.days > 0 {
+		if left := time.Duration(m.days)*24*time.Hour - age; left > 0 {
+			when += ", purged in " + shortDuration(left)
+		} else {
+			when += ", purged at next start"
+		}
+	}
+
+	if selected {
+		line := task.Title + "  " + when
+		if glyphs.Markers {
+			line = cursorMarker + " " + line
+		}
+		return trashSelectedItemStyle.Render(line)
+	}
+	return trashItemStyle.Render(task.Title + "  " + trashAgeStyle.Render(when))
+}
@@ -0,0 +1,63 @@
+package views
+
+import (
+	"github.com/sabry-awad97/task-manager/internal/config"
+	"github.com/sabry-awad97/task-manager/internal/tui/models"
+)
+
+// workflow is the live set of task states
+var workflow = newWorkflow(config.DefaultStatuses, "todo")
+
+// SetWorkflow makes the configured states live. Call it before building the views.
+func SetWorkflow(statuses []config.Status, initial string) {
+	workflow = newWorkflow(statuses, initial)
+}
+
+// CurrentWorkflow returns the live workflow
+func CurrentWorkflow() models.Workflow {
+	return workflow
+}
+
+func newWorkflow(statuses []config.Status, initial string) models.Workflow {
+	w := models.Workflow{Initial: initial}
+	for _, s := range statuses {
+		name := s.Name
+		if name == "" {
+			name = s.ID
+		}
+		w.States = append(w.States, models.Status{
+			ID:    s.ID,
+			Name:  name,
+			Color: s.Color,
+			Icon:  s.Icon,
+			Done:  s.Done,
+			Next:  s.Next,
+		})
+	}
+	return w
+}
+
+// statusIcon returns the state's own icon, or the generic done and pending
+// glyphs when it has none or emoji are off
+func statusIcon(s models.Status) string {
+	if s.Icon != "" && !display.ASCII && !display.ScreenReader {
+		return s.Icon
+	}
+	if s.Done {
+		return glyphs.Done
+	}
+	return glyphs.Pending
+}
+
+// statusLabel names a state with its icon, or with a text marker when
+// colour is not enough
+func statusLabel(s models.Status) string {
+	if glyphs.Markers {
+		marker := pendingMarker
+		if s.Done {
+			marker = doneMarker
+		}
+		return marker + " " + s.Name
+	}
+	return withIcon(statusIcon(s), s.Name)
+}
@@ -0,0 +1,113 @@
+// Package zone records where clickable elements land on screen. Views wrap
+// elements with Mark while rendering, the root model passes each frame
+// through Scan, and mouse handlers look the elements up with Get.
+package zone
+
+import (
+	"fmt"
+	"regexp"
+	"strconv"
+	"strings"
+	"sync"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/x/ansi"
+)
+
+// Zone is the rectangle an element occupied in the last scanned frame.
+// Coordinates are inclusive and zero based, like mouse coordinates.
+type Zone struct {
+	StartX, StartY int
+	EndX, EndY     int
+	found          bool
+}
+
+// InBounds reports whether the mouse event happened inside the zone
+func (z Zone) InBounds(msg tea.MouseMsg) bool {
+	return z.found &&
+		msg.X >= z.StartX && msg.X <= z.EndX &&
+		msg.Y >= z.StartY && msg.Y <= z.EndY
+}
+
+// Pos returns the mouse position relative to the zone's top left corner
+func (z Zone) Pos(msg tea.MouseMsg) (x, y int) {
+	return msg.X - z.StartX, msg.Y - z.StartY
+}
+
+// Found reports whether the zone was rendered in the last frame
+func (z Zone) Found() bool {
+	return z.found
+}
+
+// Markers are private CSI sequences, which measure as zero width everywhere
+// lipgloss and ansi compute widths. The same marker opens and closes a zone.
+var markerPattern = regexp.MustCompile(`\x1b\[(\d+)z`)
+
+var (
+	mu    sync.Mutex
+	ids   = make(map[string]int)
+	names []string
+	zones = make(map[string]Zone)
+)
+
+// Mark wraps s so its rectangle is recorded by the next Scan
+func Mark(id, s string) string {
+	mu.Lock()
+	n, ok := ids[id]
+	if !ok {
+		n = len(names)
+		ids[id] = n
+		names = append(names, id)
+	}
+	mu.Unlock()
+
+	marker := fmt.Sprintf("\x1b[%dz", n)
+	return marker + s + marker
+}
+
+// Scan records the zones marked in a rendered frame and returns the frame
+// without markers. Zones not present in the frame are forgotten.
+func Scan(frame string) string {
+	mu.Lock()
+	defer mu.Unlock()
+
+	zones = make(map[string]Zone)
+	open := make(map[string]Zone)
+
+	lines := strings.Split(frame, "\n")
+	for y, line := range lines {
+		matches := markerPattern.FindAllStringSubmatchIndex(line, -1)
+		for _, match := range matches {
+			n, err := strconv.Atoi(line[match[2]:match[3]])
+			if err != nil || n >= len(names) {
+				continue
+			}
+			id := names[n]
+			x := ansi.StringWidth(line[:match[0]])
+
+			if z, ok := open[id]; ok {
+				z.EndX, z.EndY = x-1, y
+				// A block that ends at the left edge of its last line still
+				// covers the width of its first line
+				z.EndX = max(z.EndX, z.StartX)
+				z.found = true
+				zones[id] = z
+				delete(open, id)
+			} else {
+				open[id] = Zone{StartX: x, StartY: y}
+			}
+		}
+		if len(matches) > 0 {
+			lines[y] = markerPattern.ReplaceAllString(line, "")
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// Get returns the rectangle id occupied in the last scanned frame
+func Get(id string) Zone {
+	mu.Lock()
+	defer mu.Unlock()
+	return zones[id]
+}